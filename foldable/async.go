@@ -0,0 +1,58 @@
+package foldable
+
+// Async is implemented by Foldables which are read while they are still being built, like Channel
+// appending to one blocks until the item is read, so it can't be built and then returned like a List
+// instead, a fold into an Async result happens in a go func, and the result is returned straight away
+// and the result has to be told when nothing more will be appended, so whatever is reading it can finish
+// every function in this package checks for Async rather than for Channel, so any streaming type can be used
+type Async interface {
+	Foldable
+	// Close is called once nothing more will be appended
+	Close()
+}
+
+// FoldlAsync is the Foldl for an Async Foldable, which makes sure it never blocks when folding into another Async
+// read calls each for every item in turn, and stops as soon as each returns false
+// if the result is Async, the fold happens in a go func which closes the result at the end
+// and foldFunc is relying on Append to mutate the result, rather than returning a new one
+// otherwise it's a normal fold, which blocks until read is finished
+func FoldlAsync(init T, read func(each func(T) bool), foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	if async, ok := result.(Async); ok {
+		// we don't want to block on processing, so we process in a go func and return the result
+		go func() {
+			read(func(item T) bool {
+				// normally a fold would reassign the result here, but a channel is naturally mutable
+				// so we're relying on the foldFunc to use Append to mutate the passed in result
+				// this isn't very functional, but shouldn't be a real problem if the interface is used as intended
+				_, more := foldFunc(result, item)
+				return more
+			})
+			async.Close()
+		}()
+		return result
+	}
+	// if the result is some other type, normal handling will be fine
+	read(func(item T) bool {
+		var more bool
+		result, more = foldFunc(result, item)
+		return more
+	})
+	return result
+}
+
+// isAsync returns true for a Foldable which is read while it's being built
+func isAsync(foldable Foldable) bool {
+	_, ok := foldable.(Async)
+	return ok
+}
+
+// asyncOf returns the items in the same kind of Async as kind, without blocking
+func asyncOf(kind Foldable, items Foldable) Foldable {
+	channel := ToChannel(items)
+	if _, ok := kind.(Channel); ok {
+		return channel
+	}
+	// the channel is also Async, so this folds in a go func
+	return MapToType(kind, channel, func(next T) T { return next })
+}
@@ -0,0 +1,127 @@
+package foldable
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// stream is a third party Async, so nothing should treat it differently to a Channel
+type stream struct {
+	items chan T
+}
+
+func streamOf(items ...T) stream {
+	s := stream{items: make(chan T)}
+	go func() {
+		for _, item := range items {
+			s.items <- item
+		}
+		close(s.items)
+	}()
+	return s
+}
+
+func (s stream) Foldl(init T, foldFunc func(result, next T) T) T {
+	return FoldlAsync(init, s.read, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (s stream) read(each func(T) bool) {
+	for item := range s.items {
+		if !each(item) {
+			return
+		}
+	}
+}
+
+func (s stream) Init() Foldable {
+	return stream{items: make(chan T)}
+}
+
+func (s stream) Append(item T) Foldable {
+	s.items <- item
+	return s
+}
+
+func (s stream) Close() {
+	close(s.items)
+}
+
+// fromStream checks the result is still a stream, and returns its items
+// the result of a context stage is a stream inside a ContextAsync
+func fromStream(t *testing.T, foldable Foldable) []T {
+	t.Helper()
+	if result, ok := foldable.(ContextAsync); ok {
+		foldable = result.Async
+	}
+	if _, ok := foldable.(stream); !ok {
+		t.Fatalf("result == %T expected a stream", foldable)
+	}
+	return ToList(foldable)
+}
+
+func TestAsyncDerivedFunctions(t *testing.T) {
+	double := func(x T) T { return x.(int) * 2 }
+	tests := []struct {
+		name     string
+		got      func() Foldable
+		expected []T
+		// the result is always a Channel, whatever the input was
+		channel bool
+	}{
+		{"Map", func() Foldable { return Map(streamOf(1, 2, 3), double) }, []T{2, 4, 6}, false},
+		{"Filter", func() Foldable { return Filter(streamOf(1, 2, 3), func(x T) bool { return x != 2 }) }, []T{1, 3}, false},
+		{"Take", func() Foldable { return Take(streamOf(1, 2, 3), 2) }, []T{1, 2}, false},
+		{"ParMap", func() Foldable { return ParMap(streamOf(1, 2, 3), double) }, []T{2, 4, 6}, false},
+		{"ParMapN", func() Foldable { return ParMapN(streamOf(1, 2, 3), 2, double) }, []T{2, 4, 6}, false},
+		{"SortBy", func() Foldable {
+			sorted, _ := SortBy(streamOf(3, 1, 2), intLess)
+			return sorted
+		}, []T{1, 2, 3}, false},
+		{"Scan", func() Foldable { return Scan(streamOf(1, 2), 0, add) }, []T{0, 1, 3}, false},
+		{"Distinct", func() Foldable { return Distinct(streamOf(1, 1, 2)) }, []T{1, 2}, false},
+		{"ZipWith", func() Foldable { return ZipWith(List{1, 2}, streamOf(3, 4), add) }, []T{4, 6}, false},
+		{"FlatMap", func() Foldable {
+			return FlatMap(streamOf(1), func(x T) Foldable { return List{x, x} })
+		}, []T{1, 1}, false},
+		{"MapContext", func() Foldable {
+			mapped, _ := MapContext(context.Background(), streamOf(1, 2, 3), double)
+			return mapped
+		}, []T{2, 4, 6}, false},
+		{"ToChannelContext", func() Foldable {
+			return ToChannelContext(context.Background(), streamOf(1, 2, 3))
+		}, []T{1, 2, 3}, true},
+	}
+	for _, test := range tests {
+		var got []T
+		if test.channel {
+			got = ToList(test.got().(Channel))
+		} else {
+			got = fromStream(t, test.got())
+		}
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("%s result == %v expected %v", test.name, got, test.expected)
+		}
+	}
+}
+
+func TestAsyncGroupsAndChunks(t *testing.T) {
+	groups := GroupBy(streamOf(1, 2, 3), parity)
+	if got := fromStream(t, groups["odd"].(Foldable)); !reflect.DeepEqual([]T{1, 3}, got) {
+		t.Errorf("result == %v expected %v", got, []T{1, 3})
+	}
+	chunks := fromStream(t, Chunk(streamOf(1, 2, 3), 2))
+	if got := fromStream(t, chunks[1].(Foldable)); !reflect.DeepEqual([]T{3}, got) {
+		t.Errorf("result == %v expected %v", got, []T{3})
+	}
+}
+
+func TestAsyncToChannel(t *testing.T) {
+	expected := []T{1, 2}
+	got := ToList(ToChannel(streamOf(1, 2)))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
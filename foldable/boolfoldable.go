@@ -12,6 +12,17 @@ func (boolFoldable BoolFoldable) Foldl(init T, foldFunc func(result, next T) T)
 	return result
 }
 
+func (boolFoldable BoolFoldable) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, x := range boolFoldable.List {
+		var more bool
+		if result, more = foldFunc(result, x); !more {
+			break
+		}
+	}
+	return result
+}
+
 func (boolFoldable BoolFoldable) Init() Foldable {
 	return BoolFoldable{}
 }
@@ -1,36 +1,55 @@
 package foldable
 
 import (
-	"reflect"
+	"context"
 )
 
 // Channel as lazy list
 // channels in go can be viewed as a lazy collection, therefore we can make a fold function for them
+// a Channel is Async, see FoldlAsync for how folding into another Channel is handled
 type Channel chan T
 
 func (channel Channel) Foldl(init T, foldFunc func(result, next T) T) T {
+	return FoldlAsync(init, channel.read, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+// FoldlWhile stops reading from the channel as soon as foldFunc returns false for more
+// any items left in the channel are not read, so the sender needs some other way to know to stop
+func (channel Channel) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	return FoldlAsync(init, channel.read, foldFunc)
+}
+
+func (channel Channel) read(each func(T) bool) {
+	for item := range channel {
+		if !each(item) {
+			return
+		}
+	}
+}
+
+// FoldlContext stops waiting for the next item as soon as the context is done
+// unlike Foldl, it never folds in a go func, see MapContext for how channel results are handled
+func (channel Channel) FoldlContext(ctx context.Context, init T, foldFunc func(result, next T) (T, error)) (T, error) {
 	result := init
-	if reflect.TypeOf(result).Name() == "Channel" {
-		// if the result is a channel we need some special handling
-		// for instance, we don't want to block on processing, so we process in a go func and return the result channel
-		// this is a pretty major concession to channels, but they are treated specially in go
-		// and there are no other special cases like this, so I think it's acceptable
-		go func() {
-			for item := range channel {
-				// normally a fold would reassign the result here, but a channel is naturally mutable
-				// so we're relying on the foldFunc to use Append to mutate the passed in result
-				// this isn't very functional, but shouldn't be a real problem if the interface is used as intended
-				foldFunc(result, item)
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case item, ok := <-channel:
+			if !ok {
+				return result, nil
+			}
+			var err error
+			if result, err = foldFunc(result, item); err != nil {
+				return result, err
 			}
-			close(result.(Channel))
-		}()
-	} else {
-		// if the result is some other type, normall handling will be fine
-		for item := range channel {
-			result = foldFunc(result, item)
 		}
 	}
-	return result
 }
 
 func (channel Channel) Init() Foldable {
@@ -43,3 +62,8 @@ func (channel Channel) Append(item T) Foldable {
 	channel <- item
 	return channel
 }
+
+// Close closes the channel, once nothing more will be appended
+func (channel Channel) Close() {
+	close(channel)
+}
@@ -159,3 +159,79 @@ func TestListToChannel(t *testing.T) {
 		t.Errorf("result == %v expected %v", got, expected)
 	}
 }
+
+// naturals is an unbounded channel, which is only stopped by closing done
+func naturals(done chan struct{}) Channel {
+	channel := make(Channel)
+	go func() {
+		defer close(channel)
+		for i := 0; ; i++ {
+			select {
+			case channel <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+func TestChannelTakeUnbounded(t *testing.T) {
+	expected := []int{0, 1, 2}
+	done := make(chan struct{})
+	defer close(done)
+	gotChannel := Take(naturals(done), 3).(Channel)
+	got := []int{}
+	for x := range gotChannel {
+		got = append(got, x.(int))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelAnyAllFindUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	if !Any(naturals(done), func(x T) bool { return x.(int) > 100 }) {
+		t.Errorf("result == %v expected %v", false, true)
+	}
+	if All(naturals(done), func(x T) bool { return x.(int) < 100 }) {
+		t.Errorf("result == %v expected %v", true, false)
+	}
+	got, found := Find(naturals(done), func(x T) bool { return x.(int) > 100 })
+	if !found || got != 101 {
+		t.Errorf("result == %v expected %v", got, 101)
+	}
+}
+
+func TestChannelParMapN(t *testing.T) {
+	expected := []int{2, 3, 4, 5, 6}
+	counter := &concurrencyCounter{}
+	gotChannel := ParMapN(
+		ToChannel(List{1, 2, 3, 4, 5}),
+		2,
+		counter.wrap(func(item T) T { return item.(int) + 1 })).(Channel)
+	got := []int{}
+	for x := range gotChannel {
+		got = append(got, x.(int))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if counter.max > 2 {
+		t.Errorf("result == %v expected at most %v", counter.max, 2)
+	}
+}
+
+func TestChannelTakeNone(t *testing.T) {
+	channel := ToChannel(List{1, 2, 3})
+	if got := ToList(Take(channel, 0)); len(got) != 0 {
+		t.Errorf("result == %v expected nothing", got)
+	}
+	// nothing was read from the input
+	expected := []T{1, 2, 3}
+	if got := ToList(channel); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
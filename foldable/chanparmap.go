@@ -0,0 +1,62 @@
+package foldable
+
+import (
+	"sync"
+)
+
+// ParMap on a Channel has to read the whole channel before anything comes out, so it can't be used on an unbounded one
+// these stream instead, each result is sent as soon as it can be
+
+// ParMapChannel applies a function in parallel to each item from the channel, with at most workers items in flight
+// results are sent in the same order as the input, so a slow item holds back the items after it
+// the number of finished items waiting on a slow one is bounded by workers as well
+func ParMapChannel(channel Channel, workers int, mapFunc func(T) T) Channel {
+	if workers < 1 {
+		workers = 1
+	}
+	// each item gets its own channel for its result, and those are queued in input order
+	// one item is always being waited on by the sender below, so the queue holds the rest of the workers
+	pending := make(chan chan T, workers-1)
+	go func() {
+		for item := range channel {
+			result := make(chan T, 1)
+			pending <- result
+			go func(item T) {
+				result <- mapFunc(item)
+			}(item)
+		}
+		close(pending)
+	}()
+	out := make(Channel)
+	go func() {
+		for result := range pending {
+			out <- <-result
+		}
+		close(out)
+	}()
+	return out
+}
+
+// ParMapChannelUnordered is ParMapChannel, but results are sent in the order they finish
+// so a slow item never holds back the others
+func ParMapChannelUnordered(channel Channel, workers int, mapFunc func(T) T) Channel {
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(Channel)
+	waitGroup := &sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		waitGroup.Add(1)
+		go func() {
+			for item := range channel {
+				out <- mapFunc(item)
+			}
+			waitGroup.Done()
+		}()
+	}
+	go func() {
+		waitGroup.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,55 @@
+package foldable
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// slowForSmall takes longer for smaller numbers, so results finish in the reverse order
+func slowForSmall(x T) T {
+	time.Sleep(time.Duration(10-x.(int)%10) * time.Millisecond)
+	return x.(int) * 2
+}
+
+func TestParMapChannelOrdered(t *testing.T) {
+	expected := []int{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}
+	counter := &concurrencyCounter{}
+	got := []int{}
+	for x := range ParMapChannel(ToChannel(List{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}), 3, counter.wrap(slowForSmall)) {
+		got = append(got, x.(int))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if counter.max > 3 {
+		t.Errorf("result == %v expected at most %v", counter.max, 3)
+	}
+}
+
+func TestParMapChannelUnbounded(t *testing.T) {
+	expected := []T{0, 2, 4, 6, 8}
+	done := make(chan struct{})
+	defer close(done)
+	got := ToList(Take(ParMapChannel(naturals(done), 4, slowForSmall), 5))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestParMapChannelUnordered(t *testing.T) {
+	expected := []int{0, 2, 4, 6, 8, 10}
+	counter := &concurrencyCounter{}
+	got := []int{}
+	for x := range ParMapChannelUnordered(ToChannel(List{0, 1, 2, 3, 4, 5}), 2, counter.wrap(slowForSmall)) {
+		got = append(got, x.(int))
+	}
+	sort.Ints(got)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if counter.max > 2 {
+		t.Errorf("result == %v expected at most %v", counter.max, 2)
+	}
+}
@@ -0,0 +1,144 @@
+package foldable
+
+import (
+	"context"
+	"sync"
+)
+
+// a Channel pipeline is a chain of go funcs, each blocked on either reading from or appending to a channel
+// if the consumer stops reading, every go func behind it blocks forever
+// these variants take a context, and every stage gives up as soon as it is done,
+// closing its result channel so the stage after it also finishes
+
+// FoldableContext is an optional extension to Foldable, for folds which may block waiting for the next item
+type FoldableContext interface {
+	Foldable
+	// the same as Foldl, but stops when the context is done or f returns an error
+	FoldlContext(ctx context.Context, init T, f func(result, next T) (T, error)) (T, error)
+}
+
+// FoldlContext folds until the context is done, foldFunc returns an error, or there are no more items
+// the error is ctx.Err() if the fold was cancelled
+func FoldlContext(ctx context.Context, foldable Foldable, init T, foldFunc func(result, next T) (T, error)) (T, error) {
+	if withContext, ok := foldable.(FoldableContext); ok {
+		return withContext.FoldlContext(ctx, init, foldFunc)
+	}
+	var err error
+	// the result is wrapped like foldInto, so an Async source never folds and closes an Async result itself
+	// the callers here append to it in their own go func, and close it once that is done
+	result := FoldlWhile(foldable, Pair{Left: init}, func(result, next T) (T, bool) {
+		if err = ctx.Err(); err != nil {
+			return result, false
+		}
+		var folded T
+		folded, err = foldFunc(result.(Pair).Left, next)
+		return Pair{Left: folded}, err == nil
+	})
+	return result.(Pair).Left, err
+}
+
+// appendContext is Append, except appending to a Channel gives up when the context is done
+// there's no way to give up on the Append of any other Async, so that has to finish by itself
+func appendContext(ctx context.Context, foldable Foldable, item T) (Foldable, error) {
+	if channel, ok := foldable.(Channel); ok {
+		select {
+		case channel <- item:
+			return channel, nil
+		case <-ctx.Done():
+			return channel, ctx.Err()
+		}
+	}
+	return foldable.Append(item), nil
+}
+
+// ContextAsync is what MapContext and FilterContext return for an Async input
+// the fold into the result happens in a go func, so its error isn't known when they return
+// it folds the same as the Async it wraps, and once that has been read to the end, Err reports why it ended
+// FoldlContext on it returns that error too, so a cancelled stage passes its error down the pipeline
+type ContextAsync struct {
+	Async
+	status *contextStatus
+}
+
+type contextStatus struct {
+	lock sync.Mutex
+	err  error
+}
+
+// Err is nil if the stage finished normally, or the error it stopped with, like ctx.Err() if it was cancelled
+// it is also nil until the stage has finished, so call it after the result has been read to the end
+func (foldable ContextAsync) Err() error {
+	foldable.status.lock.Lock()
+	defer foldable.status.lock.Unlock()
+	return foldable.status.err
+}
+
+func (foldable ContextAsync) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	return FoldlWhile(foldable.Async, init, foldFunc)
+}
+
+func (foldable ContextAsync) FoldlContext(ctx context.Context, init T, foldFunc func(result, next T) (T, error)) (T, error) {
+	result, err := FoldlContext(ctx, foldable.Async, init, foldFunc)
+	if err == nil {
+		// the wrapped Async is closed after the error is set, so reading to the end means it is known
+		err = foldable.Err()
+	}
+	return result, err
+}
+
+// foldContext folds into foldable.Init()
+// if that is Async, the fold happens in a go func which closes it once finished or cancelled,
+// so it returns straight away with a ContextAsync, which has the error once the result has been read
+func foldContext(ctx context.Context, foldable Foldable, foldFunc func(result Foldable, next T) (Foldable, error)) (Foldable, error) {
+	fold := func(init Foldable) (T, error) {
+		return FoldlContext(ctx, foldable, init, func(result, next T) (T, error) {
+			return foldFunc(result.(Foldable), next)
+		})
+	}
+	init := foldable.Init()
+	if async, ok := init.(Async); ok {
+		result := ContextAsync{Async: async, status: &contextStatus{}}
+		go func() {
+			_, err := fold(async)
+			result.status.lock.Lock()
+			result.status.err = err
+			result.status.lock.Unlock()
+			async.Close()
+		}()
+		return result, nil
+	}
+	built, finish := seqBuilder(init)
+	result, err := fold(built)
+	return finish(result.(Foldable)), err
+}
+
+// MapContext is Map, but stops when the context is done
+// for an Async input the result is a ContextAsync, see there for how the error is reported
+func MapContext(ctx context.Context, foldable Foldable, mapFunc func(T) T) (Foldable, error) {
+	return foldContext(ctx, foldable, func(result Foldable, next T) (Foldable, error) {
+		return appendContext(ctx, result, mapFunc(next))
+	})
+}
+
+// FilterContext is Filter, but stops when the context is done
+// for an Async input the result is a ContextAsync, like MapContext
+func FilterContext(ctx context.Context, foldable Foldable, filterFunc func(T) bool) (Foldable, error) {
+	return foldContext(ctx, foldable, func(result Foldable, next T) (Foldable, error) {
+		if filterFunc(next) {
+			return appendContext(ctx, result, next)
+		}
+		return result, nil
+	})
+}
+
+// ToChannelContext is ToChannel, but stops and closes the result channel when the context is done
+func ToChannelContext(ctx context.Context, foldable Foldable) Channel {
+	result := make(Channel)
+	go func() {
+		defer close(result)
+		FoldlContext(ctx, foldable, result, func(result, next T) (T, error) {
+			return appendContext(ctx, result.(Foldable), next)
+		})
+	}()
+	return result
+}
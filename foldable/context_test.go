@@ -0,0 +1,120 @@
+package foldable
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// naturalsContext is an unbounded channel, which is stopped by the context
+func naturalsContext(ctx context.Context) Channel {
+	channel := make(Channel)
+	go func() {
+		defer close(channel)
+		for i := 0; ; i++ {
+			select {
+			case channel <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return channel
+}
+
+// drained returns true if the channel is closed within a second
+func drained(channel Channel) bool {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-channel:
+			if !ok {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+func TestListMapContext(t *testing.T) {
+	expected := List{2, 4, 6}
+	got, err := MapContext(context.Background(), List{1, 2, 3}, func(x T) T { return x.(int) * 2 })
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestListFilterContextCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err := FilterContext(ctx, List{1, 2, 3}, func(x T) bool { return true })
+	if err != context.Canceled || !reflect.DeepEqual(List{}, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, context.Canceled)
+	}
+}
+
+func TestChannelContextPipeline(t *testing.T) {
+	expected := List{0, 4, 8}
+	ctx, cancel := context.WithCancel(context.Background())
+	mapped, _ := MapContext(ctx, naturalsContext(ctx), func(x T) T { return x.(int) * 2 })
+	filtered, _ := FilterContext(ctx, mapped, func(x T) bool { return x.(int)%4 == 0 })
+	// the source is unbounded, so stop it part way through
+	got, err := FoldlContext(ctx, filtered, List{}, func(result, next T) (T, error) {
+		if Length(result.(Foldable)) == 2 {
+			cancel()
+		}
+		return result.(List).Append(next), nil
+	})
+	if err != context.Canceled || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+	if !drained(mapped.(ContextAsync).Async.(Channel)) || !drained(filtered.(ContextAsync).Async.(Channel)) {
+		t.Errorf("pipeline channels were not closed")
+	}
+}
+
+func TestChannelContextErr(t *testing.T) {
+	// only the first stage is cancelled, the later stages and the reader can still tell it didn't finish
+	source, stop := context.WithCancel(context.Background())
+	defer stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	mapped, _ := MapContext(ctx, naturalsContext(source), func(x T) T {
+		if x.(int) == 2 {
+			cancel()
+		}
+		return x
+	})
+	filtered, _ := FilterContext(context.Background(), mapped, func(x T) bool { return true })
+	got, err := FoldlContext(context.Background(), filtered, List{}, func(result, next T) (T, error) {
+		return result.(List).Append(next), nil
+	})
+	if err != context.Canceled || len(got.(List)) > 3 {
+		t.Errorf("result == %v, %v expected %v", got, err, context.Canceled)
+	}
+	if mapped.(ContextAsync).Err() != context.Canceled || filtered.(ContextAsync).Err() != context.Canceled {
+		t.Errorf("result == %v, %v expected %v", mapped.(ContextAsync).Err(), filtered.(ContextAsync).Err(), context.Canceled)
+	}
+}
+
+func TestChannelContextErrFinished(t *testing.T) {
+	mapped, _ := MapContext(context.Background(), ToChannel(List{1, 2, 3}), func(x T) T { return x })
+	got := ToList(mapped)
+	if err := mapped.(ContextAsync).Err(); err != nil || !reflect.DeepEqual([]T{1, 2, 3}, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, []T{1, 2, 3})
+	}
+}
+
+func TestToChannelContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := ToChannelContext(ctx, List{1, 2, 3})
+	if got := <-channel; got != 1 {
+		t.Errorf("result == %v expected %v", got, 1)
+	}
+	// stop reading, the go func must still finish
+	cancel()
+	if !drained(channel) {
+		t.Errorf("channel was not closed")
+	}
+}
@@ -0,0 +1,150 @@
+package foldable
+
+// these all remember which items they have already seen in a go map
+// so the items, or the keys from keyFunc, must be comparable with ==
+
+// Distinct returns the items without any repeats, in the order they were first seen
+func Distinct(foldable Foldable) Foldable {
+	return DistinctBy(foldable, func(x T) T { return x })
+}
+
+// DistinctBy returns the first item for each key from keyFunc, in the order they were first seen
+// on a Channel this is streamed, but every key seen is kept, see DistinctByN
+func DistinctBy(foldable Foldable, keyFunc func(T) T) Foldable {
+	return filterFresh(foldable, func() func(T) bool {
+		seen := map[T]bool{}
+		return func(next T) bool {
+			key := keyFunc(next)
+			if seen[key] {
+				return false
+			}
+			seen[key] = true
+			return true
+		}
+	})
+}
+
+// DistinctByN is DistinctBy, but only remembers the most recent size keys
+// this keeps memory bounded on an unbounded Channel, but a repeat further apart than size isn't removed
+func DistinctByN(foldable Foldable, keyFunc func(T) T, size int) Foldable {
+	if size < 1 {
+		size = 1
+	}
+	return filterFresh(foldable, func() func(T) bool {
+		seen := map[T]bool{}
+		// the keys in the order they were seen, as a ring buffer, so the oldest can be forgotten
+		recent := make([]T, size)
+		next := 0
+		return func(item T) bool {
+			key := keyFunc(item)
+			if seen[key] {
+				return false
+			}
+			if len(seen) == size {
+				delete(seen, recent[next])
+			}
+			seen[key] = true
+			recent[next] = key
+			next = (next + 1) % size
+			return true
+		}
+	})
+}
+
+// filterFresh is Filter for a filterFunc which keeps state between items
+// a Seq can be folded more than once, so it gets a new filterFunc from newFilter each time
+func filterFresh(foldable Foldable, newFilter func() func(T) bool) Foldable {
+	if seq, ok := foldable.(Seq); ok {
+		return Seq(func() Iterator {
+			return seq.Filter(newFilter())()
+		})
+	}
+	return Filter(foldable, newFilter())
+}
+
+// Union returns the distinct items from a then b, in the same kind of Foldable as a
+func Union(a, b Foldable) Foldable {
+	return combineInto(a.Init(), func(result Foldable) Foldable {
+		seen := map[T]bool{}
+		notSeen := func(next T) bool {
+			if seen[next] {
+				return false
+			}
+			seen[next] = true
+			return true
+		}
+		return appendAll(appendAll(result, a, notSeen), b, notSeen)
+	})
+}
+
+// Intersect returns the distinct items from a which are also in b
+// b is read in full first, a is streamed if it is a Channel
+func Intersect(a, b Foldable) Foldable {
+	return combineInto(a.Init(), func(result Foldable) Foldable {
+		inB := toSet(b)
+		seen := map[T]bool{}
+		return appendAll(result, a, func(next T) bool {
+			if seen[next] || !inB[next] {
+				return false
+			}
+			seen[next] = true
+			return true
+		})
+	})
+}
+
+// Difference returns the distinct items from a which are not in b
+// b is read in full first, a is streamed if it is a Channel
+func Difference(a, b Foldable) Foldable {
+	return combineInto(a.Init(), func(result Foldable) Foldable {
+		seen := toSet(b)
+		return appendAll(result, a, func(next T) bool {
+			if seen[next] {
+				return false
+			}
+			seen[next] = true
+			return true
+		})
+	})
+}
+
+func toSet(foldable Foldable) map[T]bool {
+	return foldable.Foldl(map[T]bool{}, func(result, next T) T {
+		result.(map[T]bool)[next] = true
+		return result
+	}).(map[T]bool)
+}
+
+// combineInto builds a result from more than one foldable
+// if the target is Async, the build happens in a go func which closes it at the end
+// a Seq target is built in a List, see seqBuilder
+func combineInto(target Foldable, build func(result Foldable) Foldable) Foldable {
+	if async, ok := target.(Async); ok {
+		go func() {
+			build(async)
+			async.Close()
+		}()
+		return async
+	}
+	init, finish := seqBuilder(target)
+	return finish(build(init))
+}
+
+// appendAll appends every item from source which passes filterFunc to result
+func appendAll(result Foldable, source Foldable, filterFunc func(T) bool) Foldable {
+	return foldInto(result, source, func(result Foldable, next T) Foldable {
+		if filterFunc(next) {
+			return result.Append(next)
+		}
+		return result
+	})
+}
+
+// foldInto folds source into result, without any special handling of channels
+func foldInto(result Foldable, source Foldable, foldFunc func(result Foldable, next T) Foldable) Foldable {
+	// the result is wrapped, so that an Async source doesn't treat an Async result specially
+	// combineInto is already handling that, and there may be more to do after this fold
+	return source.Foldl(Pair{Left: result}, func(result, next T) T {
+		return Pair{Left: foldFunc(result.(Pair).Left.(Foldable), next)}
+	}).(Pair).Left.(Foldable)
+}
@@ -0,0 +1,79 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListDistinct(t *testing.T) {
+	expected := List{1, 2, 3}
+	got := Distinct(List{1, 2, 1, 3, 2, 1})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListDistinctBy(t *testing.T) {
+	expected := List{"apple", "banana"}
+	got := DistinctBy(List{"apple", "avocado", "banana", "blueberry"}, func(x T) T { return x.(string)[0] })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelDistinctByN(t *testing.T) {
+	// only the last 2 keys are remembered, so the final 1 is too far from the first to be removed
+	expected := []T{1, 2, 3, 1}
+	got := ToList(DistinctByN(ToChannel(List{1, 2, 1, 3, 3, 1}), func(x T) T { return x }, 2))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelDistinctUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	expected := []T{0, 1, 2}
+	got := ToList(Take(Distinct(Map(naturals(done), func(x T) T { return x.(int) / 3 })), 3))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListSetOperations(t *testing.T) {
+	a := List{1, 2, 2, 3}
+	b := List{3, 4, 4}
+	if got := Union(a, b); !reflect.DeepEqual(List{1, 2, 3, 4}, got) {
+		t.Errorf("result == %v expected %v", got, List{1, 2, 3, 4})
+	}
+	if got := Intersect(a, b); !reflect.DeepEqual(List{3}, got) {
+		t.Errorf("result == %v expected %v", got, List{3})
+	}
+	if got := Difference(a, b); !reflect.DeepEqual(List{1, 2}, got) {
+		t.Errorf("result == %v expected %v", got, List{1, 2})
+	}
+	// the inputs are unchanged
+	if !reflect.DeepEqual(List{1, 2, 2, 3}, a) {
+		t.Errorf("result == %v expected %v", a, List{1, 2, 2, 3})
+	}
+}
+
+func TestChannelSetOperations(t *testing.T) {
+	if got := ToList(Union(ToChannel(List{1, 2}), ToChannel(List{2, 3}))); !reflect.DeepEqual([]T{1, 2, 3}, got) {
+		t.Errorf("result == %v expected %v", got, []T{1, 2, 3})
+	}
+	if got := ToList(Intersect(ToChannel(List{1, 2}), ToChannel(List{2, 3}))); !reflect.DeepEqual([]T{2}, got) {
+		t.Errorf("result == %v expected %v", got, []T{2})
+	}
+	if got := ToList(Difference(ToChannel(List{1, 2}), List{2, 3})); !reflect.DeepEqual([]T{1}, got) {
+		t.Errorf("result == %v expected %v", got, []T{1})
+	}
+}
+
+func TestHashUnion(t *testing.T) {
+	expected := Hash{"a": 1, "b": 2}
+	got := Union(Hash{"a": 1}, Hash{"a": 1, "b": 2})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,43 @@
+package foldable
+
+import (
+	"sync"
+)
+
+// FlatMap maps each item to a Foldable, and concatenates them all into the same kind of Foldable as the input
+// for a Channel, or any other Async, each mapped Foldable is read in its own go func and merged into the result as items arrive
+// so the result is streamed, but items from different mapped Foldables can be interleaved
+func FlatMap(foldable Foldable, mapFunc func(T) Foldable) Foldable {
+	if isAsync(foldable) {
+		return asyncOf(foldable, mergeChannel(ToChannel(foldable), mapFunc))
+	}
+	if seq, ok := foldable.(Seq); ok {
+		return seq.FlatMap(mapFunc)
+	}
+	return foldable.Foldl(foldable.Init(), func(result, next T) T {
+		return Concat(result.(Foldable), mapFunc(next))
+	}).(Foldable)
+}
+
+// Flatten concatenates a Foldable of Foldables, see FlatMap
+func Flatten(foldable Foldable) Foldable {
+	return FlatMap(foldable, func(next T) Foldable { return next.(Foldable) })
+}
+
+func mergeChannel(channel Channel, mapFunc func(T) Foldable) Channel {
+	result := make(Channel)
+	go func() {
+		waitGroup := &sync.WaitGroup{}
+		for item := range channel {
+			waitGroup.Add(1)
+			go func(inner Foldable) {
+				// sending on a channel is safe from many go funcs at once
+				appendAll(result, inner, func(T) bool { return true })
+				waitGroup.Done()
+			}(mapFunc(item))
+		}
+		waitGroup.Wait()
+		close(result)
+	}()
+	return result
+}
@@ -0,0 +1,55 @@
+package foldable
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestListFlatMap(t *testing.T) {
+	expected := List{1, 1, 2, 2, 3, 3}
+	got := FlatMap(List{1, 2, 3}, func(x T) Foldable { return List{x, x} })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestIntFoldableFlatMap(t *testing.T) {
+	// the mapped Foldables don't need to be the same kind as the input
+	expected := IntFoldable{1, 10, 2, 20}
+	got := FlatMap(IntFoldable{1, 2}, func(x T) Foldable { return ToChannel(List{x, x.(int) * 10}) })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListFlatten(t *testing.T) {
+	expected := List{1, 2, 3, 4}
+	got := Flatten(List{List{1}, List{}, List{2, 3}, List{4}})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelFlatten(t *testing.T) {
+	expected := []int{1, 2, 3, 4, 5}
+	channels := ToChannel(List{ToChannel(List{1, 2}), ToChannel(List{3}), List{4, 5}})
+	got := []int{}
+	for x := range Flatten(channels).(Channel) {
+		got = append(got, x.(int))
+	}
+	// merged concurrently, so only the items are the same
+	sort.Ints(got)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelFlatMapUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	got := ToList(Take(FlatMap(naturals(done), func(x T) Foldable { return List{x} }), 3))
+	if len(got) != 3 {
+		t.Errorf("result == %v expected %v items", got, 3)
+	}
+}
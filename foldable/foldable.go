@@ -1,7 +1,6 @@
 package foldable
 
 import (
-	"reflect"
 	"sync"
 )
 
@@ -44,10 +43,39 @@ type Foldable interface {
 	Append(item T) Foldable
 }
 
+// FoldableWhile is an optional extension to Foldable, for folds which can stop part way through
+// a plain Foldl has to visit every item, even when the answer is already known
+// which is wasted work on a large list, and never finishes on an unbounded Channel
+type FoldableWhile interface {
+	Foldable
+	// the same as Foldl, but stops as soon as f returns false for more
+	FoldlWhile(init T, f func(result, next T) (T, bool)) T
+}
+
+// FoldlWhile folds until foldFunc returns false for more, or there are no more items
+// if the foldable doesn't implement FoldableWhile, the remaining items are still visited by Foldl but ignored
+func FoldlWhile(foldable Foldable, init T, foldFunc func(result, next T) (T, bool)) T {
+	if while, ok := foldable.(FoldableWhile); ok {
+		return while.FoldlWhile(init, foldFunc)
+	}
+	stopped := false
+	return foldable.Foldl(init, func(result, next T) T {
+		if stopped {
+			return result
+		}
+		result, more := foldFunc(result, next)
+		stopped = !more
+		return result
+	})
+}
+
 // there's a large number of functions that can be defined with just a (left) fold
 
 // Map applies a function to each item inside the foldable
 func Map(foldable Foldable, mapFunc func(T) T) Foldable {
+	if seq, ok := foldable.(Seq); ok {
+		return seq.Map(mapFunc)
+	}
 	return foldable.Foldl(foldable.Init(), func(result, next T) T {
 		return result.(Foldable).Append(mapFunc(next))
 	}).(Foldable)
@@ -55,6 +83,9 @@ func Map(foldable Foldable, mapFunc func(T) T) Foldable {
 
 // Filter returns all the items which pass the filter func
 func Filter(foldable Foldable, filterFunc func(T) bool) Foldable {
+	if seq, ok := foldable.(Seq); ok {
+		return seq.Filter(filterFunc)
+	}
 	return foldable.Foldl(foldable.Init(), func(result, next T) T {
 		if filterFunc(next) {
 			return result.(Foldable).Append(next)
@@ -63,40 +94,45 @@ func Filter(foldable Foldable, filterFunc func(T) bool) Foldable {
 	}).(Foldable)
 }
 
-// some generic functions operate on int values, so we need to define an internal intItem type.
-type intItem struct {
-	Value int
-}
-
 // Length returns the number of items contained in a foldable
 func Length(foldable Foldable) int {
-	count := intItem{Value: 0}
-	return foldable.Foldl(count, func(result, next T) T {
-		return intItem{Value: result.(intItem).Value + 1}
-	}).(intItem).Value
-}
-
-// some generic functions operate on boolean values, so we need to define an internal boolItem type.
-type boolItem struct {
-	Value bool
+	return FoldMap(foldable, func(T) T { return 1 }, IntSum).(int)
 }
 
 // All returns true if all items pass the filterFunc
+// it stops at the first item which fails
 func All(foldable Foldable, filterFunc func(T) bool) bool {
-	return foldable.Foldl(boolItem{Value: true}, func(result, next T) T {
-		return boolItem{Value: result.(boolItem).Value && filterFunc(next)}
-	}).(boolItem).Value
+	return FoldMap(foldable, func(next T) T { return filterFunc(next) }, BoolAll).(bool)
 }
 
 // Any returns true if any of the items pass the filterFunc
+// it stops at the first item which passes
 func Any(foldable Foldable, filterFunc func(T) bool) bool {
-	return foldable.Foldl(boolItem{Value: false}, func(result, next T) T {
-		return boolItem{Value: (result.(boolItem).Value || filterFunc(next))}
-	}).(boolItem).Value
+	return FoldMap(foldable, func(next T) T { return filterFunc(next) }, BoolAny).(bool)
+}
+
+// an internal type for a search result, as nil could be a valid item
+type foundItem struct {
+	Value T
+	Found bool
+}
+
+// Find returns the first item which passes the filterFunc, and false if there wasn't one
+func Find(foldable Foldable, filterFunc func(T) bool) (T, bool) {
+	result := FoldlWhile(foldable, foundItem{}, func(result, next T) (T, bool) {
+		if filterFunc(next) {
+			return foundItem{Value: next, Found: true}, false
+		}
+		return result, true
+	}).(foundItem)
+	return result.Value, result.Found
 }
 
 // Concat concatenates the parameters
 func Concat(a, b Foldable) Foldable {
+	if seq, ok := a.(Seq); ok {
+		return seq.Concat(b)
+	}
 	return b.Foldl(a, func(result, next T) T {
 		return result.(Foldable).Append(next)
 	}).(Foldable)
@@ -110,20 +146,36 @@ type intAndFoldable struct {
 }
 
 // Take will return the first n Items in a Foldable
+// it stops once it has n items, so it can be used on an unbounded Channel
 func Take(foldable Foldable, number int) Foldable {
-	init := intAndFoldable{Int: 0, Foldable: foldable.Init()}
-	return foldable.Foldl(init, func(result, next T) T {
-		count := result.(intAndFoldable).Int
-		previous := result.(intAndFoldable).Foldable
-		if count < number {
-			return intAndFoldable{Int: count + 1, Foldable: previous.Append(next)}
+	if seq, ok := foldable.(Seq); ok {
+		return seq.Take(number)
+	}
+	if number <= 0 {
+		// folding would read an item before finding it isn't wanted, which is lost from a Channel
+		result := foldable.Init()
+		if async, ok := result.(Async); ok {
+			async.Close()
 		}
 		return result
-	}).(intAndFoldable).Foldable
+	}
+	// the count is kept outside of the result, so the result is still the foldable itself
+	// otherwise a Channel wouldn't know to fold into the result channel in a go func
+	count := 0
+	return FoldlWhile(foldable, foldable.Init(), func(result, next T) (T, bool) {
+		if count >= number {
+			return result, false
+		}
+		count++
+		return result.(Foldable).Append(next), count < number
+	}).(Foldable)
 }
 
 // Drop will return only the items after the first n Items in a Foldable
 func Drop(foldable Foldable, number int) Foldable {
+	if seq, ok := foldable.(Seq); ok {
+		return seq.Drop(number)
+	}
 	init := intAndFoldable{Int: 0, Foldable: foldable.Init()}
 	return foldable.Foldl(init, func(result, next T) T {
 		count := result.(intAndFoldable).Int
@@ -135,33 +187,57 @@ func Drop(foldable Foldable, number int) Foldable {
 	}).(intAndFoldable).Foldable
 }
 
-func async(waitGroup *sync.WaitGroup, mapFunc func() T) *T {
+// async runs mapFunc in a go func, and returns where the result will be once waitGroup is done
+// if limit is not nil, it is used as a semaphore, so this blocks until there is room for another go func
+func async(waitGroup *sync.WaitGroup, limit chan struct{}, mapFunc func() T) *T {
 	var r T
 	waitGroup.Add(1)
+	if limit != nil {
+		limit <- struct{}{}
+	}
 	go func() {
 		r = mapFunc()
+		if limit != nil {
+			<-limit
+		}
 		waitGroup.Done()
 	}()
 	return &r
 }
 
 // ParMap applies a function in parallel to each item inside the foldable
+// every item gets its own go func, see ParMapN to limit that
 func ParMap(foldable Foldable, mapFunc func(T) T) Foldable {
+	return parMap(foldable, nil, mapFunc)
+}
+
+// ParMapN is ParMap, but with at most workers items being processed at once
+// the result is still in the same order as the input
+// a Channel, or any other Async, is streamed with ParMapChannel, rather than read in full first
+func ParMapN(foldable Foldable, workers int, mapFunc func(T) T) Foldable {
+	if workers < 1 {
+		workers = 1
+	}
+	if isAsync(foldable) {
+		return asyncOf(foldable, ParMapChannel(ToChannel(foldable), workers, mapFunc))
+	}
+	return parMap(foldable, make(chan struct{}, workers), mapFunc)
+}
+
+func parMap(foldable Foldable, limit chan struct{}, mapFunc func(T) T) Foldable {
 	waitGroup := &sync.WaitGroup{}
 	// convert each element to a space for the result, use the list foldable for this
 	// this maintains order while the mapFunc is processed in a go func
 	pendingResults := MapToType(List{}, foldable, func(next T) T {
-		return async(waitGroup, func() T { return mapFunc(next) })
+		return async(waitGroup, limit, func() T { return mapFunc(next) })
 	}).(Foldable)
 	// wait for all go funcs to finish
 	waitGroup.Wait()
 	// convert the result pointers back to the original type
 	derefPointer := func(next T) T { return *next.(*T) }
-	// instead of reflection, the Foldable interface could be extended to answer the question
-	if reflect.TypeOf(foldable).Name() == "Channel" {
-		// channels need special handling
-		// this should only be needed when we're converting from one foldable to another type
-		return Map(ToChannel(pendingResults), derefPointer).(Foldable)
+	if isAsync(foldable) {
+		// channels need special handling, the results are fed back in through a channel
+		return asyncOf(foldable, Map(pendingResults, derefPointer))
 	}
 	return MapToType(foldable, pendingResults, derefPointer).(Foldable)
 }
@@ -174,7 +250,8 @@ type Pair struct {
 
 // Partition returns a the set of elements which both pass and fail the filter function
 func Partition(foldable Foldable, filterFunc func(T) bool) (pass, failed Foldable) {
-	result := foldable.Foldl(Pair{foldable.Init(), foldable.Init()}, func(result, next T) T {
+	init, finish := seqBuilder(foldable.Init())
+	result := foldable.Foldl(Pair{init, init.Init()}, func(result, next T) T {
 		previous := result.(Pair)
 		if filterFunc(next) {
 			return Pair{
@@ -185,7 +262,7 @@ func Partition(foldable Foldable, filterFunc func(T) bool) (pass, failed Foldabl
 			Left:  previous.Left,
 			Right: previous.Right.(Foldable).Append(next)}
 	}).(Pair)
-	return result.Left.(Foldable), result.Right.(Foldable)
+	return finish(result.Left.(Foldable)), finish(result.Right.(Foldable))
 }
 
 func ToList(foldable Foldable) []T {
@@ -195,35 +272,35 @@ func ToList(foldable Foldable) []T {
 }
 
 // Zip combines corresponding pairs of values, only up to the shortest of a and b
-// It needs to convert the foldables into lists, so we can get value by index
+// see ZipWith for the kind of the result
 func Zip(a, b Foldable) Foldable {
-	result := a.Init()
-	bList := ToList(b)
-	for i, x := range ToList(a) {
-		if len(bList) > i {
-			result = result.Append(Pair{x, bList[i]})
-		}
-	}
-	return result
+	return ZipWith(a, b, func(x, y T) T { return Pair{x, y} })
 }
 
 // Unzip is the reverse process of Zip
 func Unzip(zipped Foldable) (left, right Foldable) {
-	result := zipped.Foldl(Pair{zipped.Init(), zipped.Init()}, func(result, next T) T {
+	init, finish := seqBuilder(zipped.Init())
+	result := zipped.Foldl(Pair{init, init.Init()}, func(result, next T) T {
 		previous := result.(Pair)
 		return Pair{
 			Left:  previous.Left.(Foldable).Append(next.(Pair).Left),
 			Right: previous.Right.(Foldable).Append(next.(Pair).Right)}
 	}).(Pair)
-	return result.Left.(Foldable), result.Right.(Foldable)
+	return finish(result.Left.(Foldable)), finish(result.Right.(Foldable))
 }
 
 // MapToType is the same as Map, but requires a target type in order to convert to a different type of Foldable result
 // the equivilant fold is much more complex
 func MapToType(target Foldable, foldable Foldable, mapFunc func(T) T) Foldable {
-	return foldable.Foldl(target.Init(), func(result, next T) T {
+	if _, ok := target.(Seq); ok {
+		if seq, ok := foldable.(Seq); ok {
+			return seq.Map(mapFunc)
+		}
+	}
+	init, finish := seqBuilder(target.Init())
+	return finish(foldable.Foldl(init, func(result, next T) T {
 		return result.(Foldable).Append(mapFunc(next))
-	}).(Foldable)
+	}).(Foldable))
 }
 
 // ToChannel alternative to MapToType (but for Channel)
@@ -233,7 +310,16 @@ func MapToType(target Foldable, foldable Foldable, mapFunc func(T) T) Foldable {
 // this needs to be called manually in certain instances, like when calling Map on a Channel.
 // Otherwise we don't know when folding over a channel should be done async, or when to close the result channel
 func ToChannel(foldable Foldable) Channel {
+	if channel, ok := foldable.(Channel); ok {
+		return channel
+	}
 	result := make(Channel)
+	if isAsync(foldable) {
+		// an Async foldable already folds into another Async in a go func, and closes it at the end
+		return foldable.Foldl(result, func(result, next T) T {
+			return result.(Channel).Append(next)
+		}).(Channel)
+	}
 	go func() {
 		foldable.Foldl(result, func(result, next T) T {
 			// normally a fold would reassign the result here, but a channel is naturally mutable
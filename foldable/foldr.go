@@ -0,0 +1,68 @@
+package foldable
+
+// a right fold processes the items from last to first
+// like the cons package, foldFunc takes the item first and the result second
+// haskell foldr
+// foldr :: (a -> b -> b) -> b -> [a] -> b
+
+// FoldableRight is an optional extension to Foldable, for types which can efficiently fold from the end
+type FoldableRight interface {
+	Foldable
+	Foldr(init T, f func(next, result T) T) T
+}
+
+// Foldr folds from the last item to the first
+// a Foldable without its own Foldr is read into a list first, so a Channel must be finite
+func Foldr(foldable Foldable, init T, foldFunc func(next, result T) T) T {
+	if right, ok := foldable.(FoldableRight); ok {
+		return right.Foldr(init, foldFunc)
+	}
+	items := ToList(foldable)
+	result := init
+	for i := len(items) - 1; i >= 0; i-- {
+		result = foldFunc(items[i], result)
+	}
+	return result
+}
+
+// Reverse returns the items in the opposite order
+// for a Hash this makes no difference, as the result is still folded in key order
+// like Foldr, a Channel must be finite, and nothing is sent on the result until it has all been read
+func Reverse(foldable Foldable) Foldable {
+	return combineInto(foldable.Init(), func(result Foldable) Foldable {
+		return Foldr(foldable, Pair{Left: result}, func(next, result T) T {
+			// wrapped like foldInto, so an Async result isn't treated specially by the fold
+			return Pair{Left: result.(Pair).Left.(Foldable).Append(next)}
+		}).(Pair).Left.(Foldable)
+	})
+}
+
+// Last returns the last item, and false if there are no items
+// List, IntFoldable and Vector can go straight to it, anything else is read to the end
+func Last(foldable Foldable) (T, bool) {
+	switch foldable := foldable.(type) {
+	case List:
+		if len(foldable) == 0 {
+			return nil, false
+		}
+		return foldable[len(foldable)-1], true
+	case IntFoldable:
+		if len(foldable) == 0 {
+			return nil, false
+		}
+		return foldable[len(foldable)-1], true
+	case Vector:
+		if foldable.Len() == 0 {
+			return nil, false
+		}
+		return foldable.Get(foldable.Len() - 1), true
+	}
+	// there's no early stop for a right fold, so the first call wins
+	result := Foldr(foldable, foundItem{}, func(next, result T) T {
+		if result.(foundItem).Found {
+			return result
+		}
+		return foundItem{Value: next, Found: true}
+	}).(foundItem)
+	return result.Value, result.Found
+}
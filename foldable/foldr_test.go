@@ -0,0 +1,83 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListFoldr(t *testing.T) {
+	// subtraction isn't associative, so this is different to a left fold
+	// 1 - (2 - (3 - 0))
+	got := Foldr(List{1, 2, 3}, 0, func(next, result T) T { return next.(int) - result.(int) })
+	if got != 2 {
+		t.Errorf("result == %v expected %v", got, 2)
+	}
+}
+
+func TestIntFoldableReverse(t *testing.T) {
+	expected := IntFoldable{3, 2, 1}
+	got := Reverse(IntFoldable{1, 2, 3}).(IntFoldable)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashFoldr(t *testing.T) {
+	expected := []T{"c", "b", "a"}
+	got := Foldr(Hash{"a": 1, "b": 2, "c": 3}, []T{}, func(next, result T) T {
+		return append(result.([]T), next.(HashEntry).Key)
+	})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelFoldr(t *testing.T) {
+	// Channel has no Foldr of its own, so this uses the derived one
+	expected := List{3, 2, 1}
+	got := Foldr(ToChannel(List{1, 2, 3}), List{}, func(next, result T) T {
+		return result.(List).Append(next)
+	})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListLast(t *testing.T) {
+	got, found := Last(List{1, 2, 3})
+	if !found || got != 3 {
+		t.Errorf("result == %v expected %v", got, 3)
+	}
+	got, found = Last(List{})
+	if found {
+		t.Errorf("result == %v expected nothing", got)
+	}
+}
+
+func TestChannelReverse(t *testing.T) {
+	expected := []T{3, 2, 1}
+	got := ToList(Reverse(ToChannel(List{1, 2, 3})))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestLastIndexed(t *testing.T) {
+	// IntFoldable and Vector go straight to the last item, a Channel still has to be read to the end
+	tests := []struct {
+		in       Foldable
+		expected T
+	}{
+		{IntFoldable{1, 2, 3}, 3},
+		{VectorOf(1, 2, 3), 3},
+		{ToChannel(List{1, 2, 3}), 3},
+	}
+	for _, test := range tests {
+		if got, found := Last(test.in); !found || got != test.expected {
+			t.Errorf("result == %v expected %v", got, test.expected)
+		}
+	}
+	if got, found := Last(Vector{}); found {
+		t.Errorf("result == %v expected nothing", got)
+	}
+}
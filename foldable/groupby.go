@@ -0,0 +1,52 @@
+package foldable
+
+// GroupBy puts each item into a group by the key from keyFunc
+// each group is the same kind of Foldable as the input, with items in the same order as the input
+// a Channel, or any other Async, has to be read in full first, then each group is its own Async of the same kind
+func GroupBy(foldable Foldable, keyFunc func(T) string) Hash {
+	target, finish := seqBuilder(foldable.Init())
+	if isAsync(target) {
+		// appending to a channel would block until something reads it, so group into lists first
+		target = List{}
+	}
+	groups := foldable.Foldl(Hash{}, func(result, next T) T {
+		groups := result.(Hash)
+		key := keyFunc(next)
+		group, ok := groups[key]
+		if !ok {
+			group = target.Init()
+		}
+		groups[key] = group.(Foldable).Append(next)
+		return groups
+	}).(Hash)
+	for key, group := range groups {
+		if isAsync(foldable) {
+			groups[key] = asyncOf(foldable, group.(Foldable))
+		} else {
+			groups[key] = finish(group.(Foldable))
+		}
+	}
+	return groups
+}
+
+// AggregateBy folds the items in each group separately, with the same init and foldFunc for each group
+// init is shared between the groups, so foldFunc must not mutate it
+func AggregateBy(foldable Foldable, keyFunc func(T) string, init T, foldFunc func(result, next T) T) Hash {
+	return foldable.Foldl(Hash{}, func(result, next T) T {
+		groups := result.(Hash)
+		key := keyFunc(next)
+		group, ok := groups[key]
+		if !ok {
+			group = init
+		}
+		groups[key] = foldFunc(group, next)
+		return groups
+	}).(Hash)
+}
+
+// CountBy counts the items in each group
+func CountBy(foldable Foldable, keyFunc func(T) string) Hash {
+	return AggregateBy(foldable, keyFunc, 0, func(result, next T) T {
+		return result.(int) + 1
+	})
+}
@@ -0,0 +1,74 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func parity(x T) string {
+	if x.(int)%2 == 0 {
+		return "even"
+	}
+	return "odd"
+}
+
+func TestListGroupBy(t *testing.T) {
+	expected := Hash{"even": List{2, 4}, "odd": List{1, 3, 5}}
+	got := GroupBy(List{1, 2, 3, 4, 5}, parity)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestIntFoldableGroupBy(t *testing.T) {
+	expected := Hash{"even": IntFoldable{2, 4}, "odd": IntFoldable{1, 3, 5}}
+	got := GroupBy(IntFoldable{1, 2, 3, 4, 5}, parity)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelGroupBy(t *testing.T) {
+	expected := map[string][]T{"even": {2, 4}, "odd": {1, 3, 5}}
+	got := map[string][]T{}
+	// every group can be read in any order
+	for key, group := range GroupBy(ToChannel(List{1, 2, 3, 4, 5}), parity) {
+		got[key] = ToList(group.(Channel))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListCountBy(t *testing.T) {
+	expected := Hash{"even": 2, "odd": 3}
+	got := CountBy(List{1, 2, 3, 4, 5}, parity)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashAggregateBy(t *testing.T) {
+	// sum the values of a Hash by the first letter of each key
+	expected := Hash{"a": 3, "b": 30}
+	got := AggregateBy(
+		Hash{"a1": 1, "a2": 2, "b1": 10, "b2": 20},
+		func(x T) string { return x.(HashEntry).Key[:1] },
+		0,
+		func(result, next T) T { return result.(int) + next.(HashEntry).Value.(int) })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestGroupByFoldOrder(t *testing.T) {
+	// the groups are folded in key order, and items within a group are in input order
+	expected := List{"even", 2, 4, "odd", 1, 3, 5}
+	got := GroupBy(List{1, 2, 3, 4, 5}, parity).Foldl(List{}, func(result, next T) T {
+		entry := next.(HashEntry)
+		return Concat(result.(List).Append(entry.Key), entry.Value.(List))
+	})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
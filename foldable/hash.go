@@ -22,6 +22,26 @@ func (foldable Hash) Foldl(init T, foldFunc func(result, next T) T) T {
 	return result
 }
 
+func (foldable Hash) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, key := range foldable.sortedKeys() {
+		var more bool
+		if result, more = foldFunc(result, HashEntry{Key: key, Value: foldable[key]}); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (foldable Hash) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	keys := foldable.sortedKeys()
+	for i := len(keys) - 1; i >= 0; i-- {
+		result = foldFunc(HashEntry{Key: keys[i], Value: foldable[keys[i]]}, result)
+	}
+	return result
+}
+
 func (foldable Hash) Init() Foldable {
 	return make(Hash)
 }
@@ -40,3 +60,5 @@ func (foldable Hash) sortedKeys() []string {
 	sort.Strings(keys)
 	return keys
 }
+
+func (foldable Hash) keyOrdered() {}
@@ -96,3 +96,13 @@ func TestHashParMap(t *testing.T) {
 		t.Errorf("result == %v expected %v", got, expected)
 	}
 }
+
+func TestHashFind(t *testing.T) {
+	expected := HashEntry{Key: "b", Value: -2}
+	got, found := Find(
+		Hash{"a": 1, "b": -2, "c": 3, "d": -30},
+		func(x T) bool { return x.(HashEntry).Value.(int) < 0 })
+	if !found || !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
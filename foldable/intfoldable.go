@@ -10,6 +10,25 @@ func (intFoldable IntFoldable) Foldl(init T, foldFunc func(result, next T) T) T
 	return result
 }
 
+func (intFoldable IntFoldable) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, x := range intFoldable {
+		var more bool
+		if result, more = foldFunc(result, x); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (intFoldable IntFoldable) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	for i := len(intFoldable) - 1; i >= 0; i-- {
+		result = foldFunc(intFoldable[i], result)
+	}
+	return result
+}
+
 func (intFoldable IntFoldable) Init() Foldable {
 	return IntFoldable{}
 }
@@ -0,0 +1,49 @@
+package foldable
+
+import (
+	"iter"
+)
+
+// go 1.23 added range over func, which lets any Foldable be used in a for loop
+// and the iterators from the standard library be turned into Foldables
+// the function All already means something else here, so the iterator of items is called Values, like slices.Values
+
+// Values returns an iterator over the items, in fold order
+// breaking out of the loop stops the fold, so this works on an unbounded Channel or Seq
+func Values(foldable Foldable) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		FoldlWhile(foldable, nil, func(result, next T) (T, bool) {
+			return result, yield(next)
+		})
+	}
+}
+
+// All returns an iterator over the keys and values, in key order
+func (foldable Hash) All() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		for _, key := range foldable.sortedKeys() {
+			if !yield(key, foldable[key]) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq returns a List of the items from an iterator, like slices.Values or maps.Keys
+func FromSeq[V any](seq iter.Seq[V]) List {
+	result := List{}
+	for item := range seq {
+		result = append(result, item)
+	}
+	return result
+}
+
+// FromSeq2 returns a Hash of the keys and values from an iterator, like maps.All
+// if a key is repeated, the last value is kept
+func FromSeq2[K ~string, V any](seq iter.Seq2[K, V]) Hash {
+	result := Hash{}
+	for key, value := range seq {
+		result[string(key)] = value
+	}
+	return result
+}
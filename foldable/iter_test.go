@@ -0,0 +1,81 @@
+package foldable
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestListValues(t *testing.T) {
+	expected := []T{1, 2, 3}
+	got := []T{}
+	for x := range Values(List{1, 2, 3}) {
+		got = append(got, x)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelValuesBreak(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	expected := []T{0, 1, 2}
+	got := []T{}
+	for x := range Values(naturals(done)) {
+		if x.(int) > 2 {
+			break
+		}
+		got = append(got, x)
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestOnlyFoldlValuesBreak(t *testing.T) {
+	// without FoldlWhile, the rest of the items are still folded, but must not be yielded
+	expected := []T{1}
+	got := []T{}
+	for x := range Values(onlyFoldl{List{1, 2, 3}}) {
+		got = append(got, x)
+		break
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashAllIterator(t *testing.T) {
+	expected := []string{"a=1", "b=2"}
+	got := []string{}
+	for key, value := range (Hash{"c": 3, "b": 2, "a": 1}).All() {
+		if key == "c" {
+			break
+		}
+		got = append(got, fmt.Sprintf("%s=%v", key, value))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	expected := List{2, 4, 6}
+	got := Map(FromSeq(slices.Values([]int{1, 2, 3})), func(x T) T { return x.(int) * 2 })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestFromSeq2(t *testing.T) {
+	expected := Hash{"b": 2}
+	got := Filter(FromSeq2(maps.All(map[string]int{"a": 1, "b": 2})), func(x T) bool {
+		return x.(HashEntry).Value.(int) > 1
+	})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
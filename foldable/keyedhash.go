@@ -0,0 +1,189 @@
+package foldable
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"reflect"
+	"sort"
+)
+
+// KeyedHash is a Hash with any comparable key, instead of only strings
+// it folds in the order of its keys from less, so int or struct keys don't have to be turned into strings to get a sensible order
+// like Hash, Append changes the underlying map, and the order is sorted again on every fold
+// the zero value is an empty KeyedHash in the natural order of the keys, which must be numbers or strings
+type KeyedHash[K comparable] struct {
+	items map[K]T
+	less  func(a, b K) bool
+}
+
+// KeyedEntry is the key value pair
+type KeyedEntry[K comparable] struct {
+	Key   K
+	Value T
+}
+
+// NewKeyedHash returns an empty KeyedHash which folds in the order from less
+func NewKeyedHash[K comparable](less func(a, b K) bool) KeyedHash[K] {
+	return KeyedHash[K]{items: map[K]T{}, less: less}
+}
+
+// OrderedKeyedHash returns an empty KeyedHash which folds in the natural order of the keys
+func OrderedKeyedHash[K cmp.Ordered]() KeyedHash[K] {
+	return NewKeyedHash(cmp.Less[K])
+}
+
+// KeyedHashOf returns a KeyedHash[string] with the same entries as a Hash, in the same order
+func KeyedHashOf(hash Hash) KeyedHash[string] {
+	result := OrderedKeyedHash[string]()
+	for key, value := range hash {
+		result.items[key] = value
+	}
+	return result
+}
+
+// KeyedHashOfStringInt returns a KeyedHash[string] with the same entries as a StringIntMapFoldable, in the same order
+func KeyedHashOfStringInt(foldable StringIntMapFoldable) KeyedHash[string] {
+	result := OrderedKeyedHash[string]()
+	for key, value := range foldable.Map {
+		result.items[key] = value
+	}
+	return result
+}
+
+// ToHash returns a Hash of the entries, with keyFunc turning each key into a string
+// keyFunc can be nil, then fmt.Sprint is used
+// if two keys give the same string, the last one in fold order is kept
+func (foldable KeyedHash[K]) ToHash(keyFunc func(K) string) Hash {
+	keyFunc = stringKey(keyFunc)
+	result := make(Hash, len(foldable.items))
+	for _, key := range foldable.sortedKeys() {
+		result[keyFunc(key)] = foldable.items[key]
+	}
+	return result
+}
+
+// ToStringInt returns a StringIntMapFoldable of the entries, which all need int values
+// keyFunc is the same as for ToHash
+func (foldable KeyedHash[K]) ToStringInt(keyFunc func(K) string) StringIntMapFoldable {
+	keyFunc = stringKey(keyFunc)
+	result := make(map[string]int, len(foldable.items))
+	for _, key := range foldable.sortedKeys() {
+		result[keyFunc(key)] = foldable.items[key].(int)
+	}
+	return StringIntMapFoldable{Map: result}
+}
+
+func stringKey[K comparable](keyFunc func(K) string) func(K) string {
+	if keyFunc != nil {
+		return keyFunc
+	}
+	return func(key K) string { return fmt.Sprint(key) }
+}
+
+// Len returns the number of entries
+func (foldable KeyedHash[K]) Len() int {
+	return len(foldable.items)
+}
+
+// Get returns the value for key, and false if there isn't one
+func (foldable KeyedHash[K]) Get(key K) (T, bool) {
+	value, ok := foldable.items[key]
+	return value, ok
+}
+
+// Delete removes key, and like Append this changes the underlying map
+func (foldable KeyedHash[K]) Delete(key K) KeyedHash[K] {
+	delete(foldable.items, key)
+	return foldable
+}
+
+// All returns an iterator over the keys and values, in key order
+func (foldable KeyedHash[K]) All() iter.Seq2[K, T] {
+	return func(yield func(K, T) bool) {
+		for _, key := range foldable.sortedKeys() {
+			if !yield(key, foldable.items[key]) {
+				return
+			}
+		}
+	}
+}
+
+func (foldable KeyedHash[K]) sortedKeys() []K {
+	// take and drop depend on order, so we need a guaranteed order
+	keys := make([]K, 0, len(foldable.items))
+	for k := range foldable.items {
+		keys = append(keys, k)
+	}
+	less := foldable.keyLess()
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	return keys
+}
+
+// keyLess is less, or the natural order for the zero value
+func (foldable KeyedHash[K]) keyLess() func(a, b K) bool {
+	if foldable.less != nil {
+		return foldable.less
+	}
+	return naturalKeyLess[K]
+}
+
+// naturalKeyLess is cmp.Less for any K which turns out to be a number or a string
+// K is only comparable, so this can't be checked until there are keys to compare
+func naturalKeyLess[K comparable](a, b K) bool {
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() < y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return x.Uint() < y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() < y.Float()
+	case reflect.String:
+		return x.String() < y.String()
+	}
+	panic(fmt.Sprintf("foldable: no natural order for KeyedHash keys of %T, use NewKeyedHash", a))
+}
+
+func (foldable KeyedHash[K]) Foldl(init T, foldFunc func(result, next T) T) T {
+	result := init
+	for _, key := range foldable.sortedKeys() {
+		result = foldFunc(result, KeyedEntry[K]{Key: key, Value: foldable.items[key]})
+	}
+	return result
+}
+
+func (foldable KeyedHash[K]) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, key := range foldable.sortedKeys() {
+		var more bool
+		if result, more = foldFunc(result, KeyedEntry[K]{Key: key, Value: foldable.items[key]}); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (foldable KeyedHash[K]) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	keys := foldable.sortedKeys()
+	for i := len(keys) - 1; i >= 0; i-- {
+		result = foldFunc(KeyedEntry[K]{Key: keys[i], Value: foldable.items[keys[i]]}, result)
+	}
+	return result
+}
+
+// Init keeps the order, so Filter and Map into the same kind keep folding the same way
+func (foldable KeyedHash[K]) Init() Foldable {
+	return NewKeyedHash(foldable.keyLess())
+}
+
+func (foldable KeyedHash[K]) Append(item T) Foldable {
+	if foldable.items == nil {
+		foldable.items = map[K]T{}
+	}
+	foldable.items[item.(KeyedEntry[K]).Key] = item.(KeyedEntry[K]).Value
+	return foldable
+}
+
+func (foldable KeyedHash[K]) keyOrdered() {}
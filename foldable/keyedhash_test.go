@@ -0,0 +1,83 @@
+package foldable
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestKeyedHashIntKeys(t *testing.T) {
+	// as strings, 10 would be before 9
+	in := OrderedKeyedHash[int]().Append(KeyedEntry[int]{10, "ten"}).Append(KeyedEntry[int]{9, "nine"})
+	expected := []T{"nine", "ten"}
+	got := ToList(MapToType(List{}, in, func(x T) T { return x.(KeyedEntry[int]).Value }))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestKeyedHashCustomOrder(t *testing.T) {
+	type point struct{ x, y int }
+	byY := NewKeyedHash(func(a, b point) bool { return a.y < b.y })
+	in := byY.Append(KeyedEntry[point]{point{1, 2}, "a"}).Append(KeyedEntry[point]{point{2, 1}, "b"})
+	expected := []T{KeyedEntry[point]{point{2, 1}, "b"}, KeyedEntry[point]{point{1, 2}, "a"}}
+	got := ToList(in)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	reversed := Foldr(in, []T{}, func(next, result T) T { return append(result.([]T), next) })
+	if !reflect.DeepEqual([]T{expected[1], expected[0]}, reversed) {
+		t.Errorf("result == %v expected %v", reversed, []T{expected[1], expected[0]})
+	}
+}
+
+func TestKeyedHashFilterKeepsOrder(t *testing.T) {
+	in := NewKeyedHash(func(a, b int) bool { return a > b })
+	for i := 1; i <= 5; i++ {
+		in.Append(KeyedEntry[int]{i, i})
+	}
+	got := Filter(in, func(x T) bool { return x.(KeyedEntry[int]).Key%2 == 1 })
+	expected := []T{KeyedEntry[int]{5, 5}, KeyedEntry[int]{3, 3}, KeyedEntry[int]{1, 1}}
+	if !reflect.DeepEqual(expected, ToList(got)) {
+		t.Errorf("result == %v expected %v", ToList(got), expected)
+	}
+}
+
+func TestKeyedHashConversions(t *testing.T) {
+	hash := Hash{"a": 1, "b": 2}
+	if got := KeyedHashOf(hash).ToHash(nil); !reflect.DeepEqual(hash, got) {
+		t.Errorf("result == %v expected %v", got, hash)
+	}
+	ints := StringIntMapFoldable{Map: map[string]int{"a": 1, "b": 2}}
+	if got := KeyedHashOfStringInt(ints).ToStringInt(nil); !reflect.DeepEqual(ints, got) {
+		t.Errorf("result == %v expected %v", got, ints)
+	}
+	in := OrderedKeyedHash[int]().Append(KeyedEntry[int]{1, 10}).(KeyedHash[int])
+	expected := Hash{"#1": 10}
+	if got := in.ToHash(func(k int) string { return "#" + strconv.Itoa(k) }); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestKeyedHashSortBy(t *testing.T) {
+	in := OrderedKeyedHash[int]().Append(KeyedEntry[int]{1, 2}).Append(KeyedEntry[int]{2, 1})
+	got, err := SortBy(in, func(a, b T) bool { return a.(KeyedEntry[int]).Value.(int) < b.(KeyedEntry[int]).Value.(int) })
+	expected := List{KeyedEntry[int]{2, 1}, KeyedEntry[int]{1, 2}}
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestKeyedHashZeroValue(t *testing.T) {
+	in := KeyedHash[int]{}.Append(KeyedEntry[int]{10, "ten"}).Append(KeyedEntry[int]{9, "nine"})
+	expected := []T{KeyedEntry[int]{9, "nine"}, KeyedEntry[int]{10, "ten"}}
+	if got := ToList(in); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if got := ToList(Filter(in, func(T) bool { return true })); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if (KeyedHash[string]{}).Len() != 0 || len(ToList(KeyedHash[string]{})) != 0 {
+		t.Errorf("result == %v expected an empty hash", ToList(KeyedHash[string]{}))
+	}
+}
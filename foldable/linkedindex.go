@@ -0,0 +1,85 @@
+package foldable
+
+import (
+	"container/list"
+)
+
+// linkedIndex keeps items in the order they were added, in a linked list, with a go map from each key to its place in the list
+// so finding, adding and removing an item are all O(1), and the order never needs sorting
+// OrderedHash and Set are both built on it, keyed by the entry key and by the item itself
+// a nil *linkedIndex is empty
+type linkedIndex[K comparable] struct {
+	index map[K]*list.Element
+	items *list.List
+}
+
+func newLinkedIndex[K comparable]() *linkedIndex[K] {
+	return &linkedIndex[K]{index: map[K]*list.Element{}, items: list.New()}
+}
+
+func (linked *linkedIndex[K]) len() int {
+	if linked == nil {
+		return 0
+	}
+	return len(linked.index)
+}
+
+func (linked *linkedIndex[K]) get(key K) (T, bool) {
+	if linked == nil {
+		return nil, false
+	}
+	if element, ok := linked.index[key]; ok {
+		return element.Value, true
+	}
+	return nil, false
+}
+
+// set adds item at the end, or replaces the item with the same key, keeping its place
+func (linked *linkedIndex[K]) set(key K, item T) {
+	if element, ok := linked.index[key]; ok {
+		element.Value = item
+		return
+	}
+	linked.index[key] = linked.items.PushBack(item)
+}
+
+// remove removes the item with key, and the items after it move up
+func (linked *linkedIndex[K]) remove(key K) {
+	if linked == nil {
+		return
+	}
+	if element, ok := linked.index[key]; ok {
+		linked.items.Remove(element)
+		delete(linked.index, key)
+	}
+}
+
+func (linked *linkedIndex[K]) foldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	if linked == nil {
+		return result
+	}
+	// next is read first, so that foldFunc can remove the item it was given
+	for element := linked.items.Front(); element != nil; {
+		next := element.Next()
+		var more bool
+		if result, more = foldFunc(result, element.Value); !more {
+			break
+		}
+		element = next
+	}
+	return result
+}
+
+func (linked *linkedIndex[K]) foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	if linked == nil {
+		return result
+	}
+	for element := linked.items.Back(); element != nil; {
+		previous := element.Prev()
+		result = foldFunc(element.Value, result)
+		element = previous
+	}
+	return result
+}
@@ -11,6 +11,25 @@ func (list List) Foldl(init T, foldFunc func(result, next T) T) T {
 	return result
 }
 
+func (list List) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, x := range list {
+		var more bool
+		if result, more = foldFunc(result, x); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (list List) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	for i := len(list) - 1; i >= 0; i-- {
+		result = foldFunc(list[i], result)
+	}
+	return result
+}
+
 func (list List) Init() Foldable {
 	return List{}
 }
@@ -3,6 +3,7 @@ package foldable
 import (
 	"fmt"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -138,3 +139,97 @@ func TestListUnzip(t *testing.T) {
 		t.Errorf("result == %v expected %v", gotB, b)
 	}
 }
+
+func TestListFind(t *testing.T) {
+	got, found := Find(List{0, -1, 1, -2}, func(x T) bool { return x.(int) < 0 })
+	if !found || got != -1 {
+		t.Errorf("result == %v, %v expected %v, %v", got, found, -1, true)
+	}
+	got, found = Find(List{0, 1}, func(x T) bool { return x.(int) < 0 })
+	if found || got != nil {
+		t.Errorf("result == %v, %v expected %v, %v", got, found, nil, false)
+	}
+}
+
+func TestListShortCircuit(t *testing.T) {
+	visited := 0
+	isNegative := func(x T) bool {
+		visited++
+		return x.(int) < 0
+	}
+	in := List{0, -1, 1, 2, -30}
+	if Any(in, isNegative); visited != 2 {
+		t.Errorf("result == %v expected %v", visited, 2)
+	}
+	visited = 0
+	if All(in, isNegative); visited != 1 {
+		t.Errorf("result == %v expected %v", visited, 1)
+	}
+	visited = 0
+	if Find(in, isNegative); visited != 2 {
+		t.Errorf("result == %v expected %v", visited, 2)
+	}
+}
+
+func TestListFoldlWhileFallback(t *testing.T) {
+	// a Foldable without FoldlWhile still stops using the results of the foldFunc
+	expected := List{1, 2}
+	got := Take(onlyFoldl{List{1, 2, 3, 4}}, 2).(onlyFoldl).List
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+// onlyFoldl hides the FoldlWhile of the List it wraps
+type onlyFoldl struct {
+	List List
+}
+
+func (o onlyFoldl) Foldl(init T, foldFunc func(result, next T) T) T {
+	return o.List.Foldl(init, foldFunc)
+}
+
+func (o onlyFoldl) Init() Foldable {
+	return onlyFoldl{List{}}
+}
+
+func (o onlyFoldl) Append(item T) Foldable {
+	return onlyFoldl{append(o.List, item)}
+}
+
+// concurrencyCounter records the most mapFuncs running at the same time
+type concurrencyCounter struct {
+	running, max int32
+}
+
+func (counter *concurrencyCounter) wrap(mapFunc func(T) T) func(T) T {
+	return func(x T) T {
+		running := atomic.AddInt32(&counter.running, 1)
+		for {
+			max := atomic.LoadInt32(&counter.max)
+			if running <= max || atomic.CompareAndSwapInt32(&counter.max, max, running) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&counter.running, -1)
+		return mapFunc(x)
+	}
+}
+
+func TestListParMapN(t *testing.T) {
+	in := List{}
+	expected := List{}
+	for i := 0; i < 20; i++ {
+		in = append(in, i)
+		expected = append(expected, i*2)
+	}
+	counter := &concurrencyCounter{}
+	got := ParMapN(in, 3, counter.wrap(func(x T) T { return x.(int) * 2 })).(List)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if counter.max > 3 {
+		t.Errorf("result == %v expected at most %v", counter.max, 3)
+	}
+}
@@ -0,0 +1,167 @@
+package foldable
+
+// a lot of folds are the same reduce function with a different starting value
+// a Monoid is that pair, an Empty value and a way to Combine two values
+// Combine must be associative, and combining with Empty must change nothing
+
+// Semigroup is a way to combine two values into one
+type Semigroup interface {
+	Combine(a, b T) T
+}
+
+// Monoid is a Semigroup with an Empty value
+type Monoid interface {
+	Semigroup
+	Empty() T
+}
+
+// Absorbing is an optional extension to Monoid, for a value which can't be changed by combining anything else with it
+// like false for BoolAll, once it is reached the fold can stop
+// it must be comparable with ==
+type Absorbing interface {
+	Monoid
+	Absorbing() T
+}
+
+type monoid struct {
+	empty   T
+	combine func(a, b T) T
+}
+
+func (m monoid) Empty() T {
+	return m.empty
+}
+
+func (m monoid) Combine(a, b T) T {
+	return m.combine(a, b)
+}
+
+type absorbingMonoid struct {
+	monoid
+	absorbing T
+}
+
+func (m absorbingMonoid) Absorbing() T {
+	return m.absorbing
+}
+
+// NewMonoid creates a Monoid from an empty value and a combine function
+func NewMonoid(empty T, combine func(a, b T) T) Monoid {
+	return monoid{empty: empty, combine: combine}
+}
+
+// NewAbsorbingMonoid creates a Monoid which can stop folding once the absorbing value is reached
+func NewAbsorbingMonoid(empty, absorbing T, combine func(a, b T) T) Monoid {
+	return absorbingMonoid{monoid: monoid{empty: empty, combine: combine}, absorbing: absorbing}
+}
+
+// built in instances
+
+// IntSum adds ints
+var IntSum = NewMonoid(0, func(a, b T) T { return a.(int) + b.(int) })
+
+// IntProduct multiplies ints
+var IntProduct = NewMonoid(1, func(a, b T) T { return a.(int) * b.(int) })
+
+// FloatSum adds float64s
+var FloatSum = NewMonoid(0.0, func(a, b T) T { return a.(float64) + b.(float64) })
+
+// FloatProduct multiplies float64s
+var FloatProduct = NewMonoid(1.0, func(a, b T) T { return a.(float64) * b.(float64) })
+
+// StringConcat joins strings
+var StringConcat = NewMonoid("", func(a, b T) T { return a.(string) + b.(string) })
+
+// ListConcat joins Lists into a new List, neither input is changed
+var ListConcat = NewMonoid(List{}, func(a, b T) T {
+	result := make(List, 0, len(a.(List))+len(b.(List)))
+	return append(append(result, a.(List)...), b.(List)...)
+})
+
+// HashMerge merges Hashes into a new Hash, neither input is changed
+// if both have the same key, the value from b is kept
+var HashMerge = NewMonoid(Hash{}, func(a, b T) T {
+	result := make(Hash, len(a.(Hash))+len(b.(Hash)))
+	for k, v := range a.(Hash) {
+		result[k] = v
+	}
+	for k, v := range b.(Hash) {
+		result[k] = v
+	}
+	return result
+})
+
+// BoolAll is true only if every value is true
+var BoolAll = NewAbsorbingMonoid(true, false, func(a, b T) T { return a.(bool) && b.(bool) })
+
+// BoolAny is true if any value is true
+var BoolAny = NewAbsorbingMonoid(false, true, func(a, b T) T { return a.(bool) || b.(bool) })
+
+// the following have no natural empty value, so nil is used
+// that means FoldMap returns nil when there are no items, and nil can't be one of the values
+
+// FirstItem keeps the first value
+var FirstItem = NewMonoid(nil, func(a, b T) T {
+	if a == nil {
+		return b
+	}
+	return a
+})
+
+// LastItem keeps the last value
+var LastItem = NewMonoid(nil, func(a, b T) T {
+	if b == nil {
+		return a
+	}
+	return b
+})
+
+// MinBy keeps the smallest value according to less, or the first of equal values
+func MinBy(less func(a, b T) bool) Monoid {
+	return NewMonoid(nil, func(a, b T) T {
+		if a == nil || (b != nil && less(b, a)) {
+			return b
+		}
+		return a
+	})
+}
+
+// MaxBy keeps the largest value according to less, or the first of equal values
+func MaxBy(less func(a, b T) bool) Monoid {
+	return NewMonoid(nil, func(a, b T) T {
+		if a == nil || (b != nil && less(a, b)) {
+			return b
+		}
+		return a
+	})
+}
+
+// FoldMap maps each item to a value of the monoid, and combines them all
+func FoldMap(foldable Foldable, mapFunc func(T) T, monoid Monoid) T {
+	if absorbing, ok := monoid.(Absorbing); ok {
+		stop := absorbing.Absorbing()
+		return FoldlWhile(foldable, monoid.Empty(), func(result, next T) (T, bool) {
+			result = monoid.Combine(result, mapFunc(next))
+			return result, result != stop
+		})
+	}
+	return foldable.Foldl(monoid.Empty(), func(result, next T) T {
+		return monoid.Combine(result, mapFunc(next))
+	})
+}
+
+// Fold combines all the items, which must already be values of the monoid
+func Fold(foldable Foldable, monoid Monoid) T {
+	return FoldMap(foldable, func(x T) T { return x }, monoid)
+}
+
+// Reduce combines all the items with a Semigroup, and false if there are no items
+func Reduce(foldable Foldable, semigroup Semigroup) (T, bool) {
+	result := foldable.Foldl(foundItem{}, func(result, next T) T {
+		if !result.(foundItem).Found {
+			return foundItem{Value: next, Found: true}
+		}
+		return foundItem{Value: semigroup.Combine(result.(foundItem).Value, next), Found: true}
+	}).(foundItem)
+	return result.Value, result.Found
+}
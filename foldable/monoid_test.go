@@ -0,0 +1,76 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFoldMonoids(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       Foldable
+		monoid   Monoid
+		expected T
+	}{
+		{"IntSum", IntFoldable{1, 2, 3}, IntSum, 6},
+		{"IntProduct", IntFoldable{2, 3, 4}, IntProduct, 24},
+		{"FloatSum", List{0.5, 1.5}, FloatSum, 2.0},
+		{"FloatProduct", List{0.5, 4.0}, FloatProduct, 2.0},
+		{"StringConcat", List{"a", "b", "c"}, StringConcat, "abc"},
+		{"ListConcat", List{List{1}, List{2, 3}}, ListConcat, List{1, 2, 3}},
+		{"HashMerge", List{Hash{"a": 1, "b": 1}, Hash{"b": 2}}, HashMerge, Hash{"a": 1, "b": 2}},
+		{"BoolAll", BoolFoldable{List: []bool{true, false}}, BoolAll, false},
+		{"BoolAny", BoolFoldable{List: []bool{false, true}}, BoolAny, true},
+		{"FirstItem", List{3, 1, 2}, FirstItem, 3},
+		{"LastItem", List{3, 1, 2}, LastItem, 2},
+		{"MinBy", List{3, 1, 2}, MinBy(func(a, b T) bool { return a.(int) < b.(int) }), 1},
+		{"MaxBy", List{3, 1, 2}, MaxBy(func(a, b T) bool { return a.(int) < b.(int) }), 3},
+		{"Empty", List{}, IntSum, 0},
+		{"EmptyMin", List{}, MinBy(func(a, b T) bool { return a.(int) < b.(int) }), nil},
+	}
+	for _, test := range tests {
+		got := Fold(test.in, test.monoid)
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("%s result == %v expected %v", test.name, got, test.expected)
+		}
+	}
+}
+
+func TestListFoldMap(t *testing.T) {
+	got := FoldMap(List{"a", "bb", "ccc"}, func(x T) T { return len(x.(string)) }, IntSum)
+	if got != 6 {
+		t.Errorf("result == %v expected %v", got, 6)
+	}
+}
+
+func TestListConcatDoesNotChangeInputs(t *testing.T) {
+	a := make(List, 1, 10)
+	a[0] = 1
+	ListConcat.Combine(a, List{2})
+	ListConcat.Combine(a, List{3})
+	if got := a[:2][1]; got != nil {
+		t.Errorf("result == %v expected %v", got, nil)
+	}
+}
+
+func TestFoldMapAbsorbingStopsEarly(t *testing.T) {
+	visited := 0
+	FoldMap(List{1, -1, 2, 3}, func(x T) T {
+		visited++
+		return x.(int) > 0
+	}, BoolAll)
+	if visited != 2 {
+		t.Errorf("result == %v expected %v", visited, 2)
+	}
+}
+
+func TestListReduce(t *testing.T) {
+	got, found := Reduce(List{1, 2, 3}, IntSum)
+	if !found || got != 6 {
+		t.Errorf("result == %v expected %v", got, 6)
+	}
+	_, found = Reduce(List{}, IntSum)
+	if found {
+		t.Errorf("result == %v expected %v", found, false)
+	}
+}
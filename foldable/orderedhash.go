@@ -0,0 +1,79 @@
+package foldable
+
+import (
+	"iter"
+)
+
+// OrderedHash is a Hash which folds in the order the keys were first added, instead of sorted
+// like a config file or http headers, where the order they were written in matters
+// Set, Get and Delete are all O(1), see linkedIndex
+// setting a key which is already there changes its value but keeps its place
+// like Hash, Append and Delete change it for everything which shares it
+// the zero value is an empty OrderedHash
+type OrderedHash struct {
+	entries *linkedIndex[string]
+}
+
+// NewOrderedHash returns an empty OrderedHash
+func NewOrderedHash() OrderedHash {
+	return OrderedHash{entries: newLinkedIndex[string]()}
+}
+
+// Len returns the number of entries
+func (foldable OrderedHash) Len() int {
+	return foldable.entries.len()
+}
+
+// Get returns the value for key, and false if there isn't one
+func (foldable OrderedHash) Get(key string) (T, bool) {
+	if entry, ok := foldable.entries.get(key); ok {
+		return entry.(HashEntry).Value, true
+	}
+	return nil, false
+}
+
+// Set adds key at the end, or changes its value if it is already there
+func (foldable OrderedHash) Set(key string, value T) OrderedHash {
+	if foldable.entries == nil {
+		foldable = NewOrderedHash()
+	}
+	foldable.entries.set(key, HashEntry{Key: key, Value: value})
+	return foldable
+}
+
+// Delete removes key, and the keys after it move up
+func (foldable OrderedHash) Delete(key string) OrderedHash {
+	foldable.entries.remove(key)
+	return foldable
+}
+
+// All returns an iterator over the keys and values, in the order they were added
+func (foldable OrderedHash) All() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		foldable.FoldlWhile(nil, func(result, next T) (T, bool) {
+			return result, yield(next.(HashEntry).Key, next.(HashEntry).Value)
+		})
+	}
+}
+
+func (foldable OrderedHash) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.FoldlWhile(init, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (foldable OrderedHash) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	return foldable.entries.foldlWhile(init, foldFunc)
+}
+
+func (foldable OrderedHash) Foldr(init T, foldFunc func(next, result T) T) T {
+	return foldable.entries.foldr(init, foldFunc)
+}
+
+func (foldable OrderedHash) Init() Foldable {
+	return NewOrderedHash()
+}
+
+func (foldable OrderedHash) Append(item T) Foldable {
+	return foldable.Set(item.(HashEntry).Key, item.(HashEntry).Value)
+}
@@ -0,0 +1,65 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func headers() OrderedHash {
+	return OrderedHash{}.Set("Host", "example.com").Set("Accept", "*/*").Set("Content-Type", "text/plain")
+}
+
+func TestOrderedHashInsertionOrder(t *testing.T) {
+	expected := []T{HashEntry{"Host", "example.com"}, HashEntry{"Accept", "*/*"}, HashEntry{"Content-Type", "text/plain"}}
+	got := ToList(headers())
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestOrderedHashUpdateKeepsPosition(t *testing.T) {
+	in := headers().Set("Host", "example.org")
+	expected := []T{HashEntry{"Host", "example.org"}, HashEntry{"Accept", "*/*"}, HashEntry{"Content-Type", "text/plain"}}
+	got := ToList(in)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if value, ok := in.Get("Host"); !ok || value != "example.org" {
+		t.Errorf("result == %v expected %v", value, "example.org")
+	}
+}
+
+func TestOrderedHashDelete(t *testing.T) {
+	in := headers().Delete("Accept").Set("Accept", "text/html")
+	expected := []T{HashEntry{"Host", "example.com"}, HashEntry{"Content-Type", "text/plain"}, HashEntry{"Accept", "text/html"}}
+	got := ToList(in)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if in.Len() != 3 {
+		t.Errorf("result == %v expected %v", in.Len(), 3)
+	}
+}
+
+func TestOrderedHashTakeDrop(t *testing.T) {
+	taken := ToList(Take(headers(), 2))
+	expected := []T{HashEntry{"Host", "example.com"}, HashEntry{"Accept", "*/*"}}
+	if !reflect.DeepEqual(expected, taken) {
+		t.Errorf("result == %v expected %v", taken, expected)
+	}
+	dropped := ToList(Drop(headers(), 2))
+	expected = []T{HashEntry{"Content-Type", "text/plain"}}
+	if !reflect.DeepEqual(expected, dropped) {
+		t.Errorf("result == %v expected %v", dropped, expected)
+	}
+}
+
+func TestOrderedHashFoldr(t *testing.T) {
+	got := Foldr(headers(), []T{}, func(next, result T) T {
+		return append(result.([]T), next.(HashEntry).Key)
+	})
+	expected := []T{"Content-Type", "Accept", "Host"}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,137 @@
+package foldable
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// ParMap has no way for a mapFunc to fail, other than to panic in a go func, which takes down the whole program
+// these variants let the mapFunc return an error, and turn a panic into an error for that item
+
+// ItemError is the error from the mapFunc for the item at Index
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (itemError ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", itemError.Index, itemError.Err)
+}
+
+func (itemError ItemError) Unwrap() error {
+	return itemError.Err
+}
+
+// ItemErrors is every error from a ParMapErr, in the order of the items
+type ItemErrors []ItemError
+
+func (itemErrors ItemErrors) Error() string {
+	messages := make([]string, 0, len(itemErrors))
+	for _, itemError := range itemErrors {
+		messages = append(messages, itemError.Error())
+	}
+	return fmt.Sprintf("%d items failed: %s", len(itemErrors), strings.Join(messages, "; "))
+}
+
+func (itemErrors ItemErrors) Unwrap() []error {
+	errs := make([]error, 0, len(itemErrors))
+	for _, itemError := range itemErrors {
+		errs = append(errs, itemError)
+	}
+	return errs
+}
+
+// PanicError is a recovered panic from a mapFunc
+type PanicError struct {
+	Value T
+	// the stack of the go func which panicked
+	Stack []byte
+}
+
+func (panicError PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", panicError.Value)
+}
+
+// ParMapErr is ParMap for a mapFunc which can fail
+// every item is processed, the result only has the items which succeeded, still in order
+// the error is ItemErrors if any of them failed
+func ParMapErr(foldable Foldable, mapFunc func(T) (T, error)) (Foldable, error) {
+	return parMapErr(context.Background(), foldable, nil, false, func(_ context.Context, next T) (T, error) {
+		return mapFunc(next)
+	})
+}
+
+// ParMapErrFailFast is ParMapErr with at most workers items being processed at once, which stops at the first error
+// the context passed to each mapFunc is cancelled once any item fails, and items which haven't started are skipped
+// if ctx is cancelled without any item failing, the error is ctx.Err()
+func ParMapErrFailFast(ctx context.Context, foldable Foldable, workers int, mapFunc func(context.Context, T) (T, error)) (Foldable, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	return parMapErr(ctx, foldable, make(chan struct{}, workers), true, mapFunc)
+}
+
+// an internal type for the outcome of a single item
+type itemResult struct {
+	Value   T
+	Err     error
+	Skipped bool
+}
+
+func parMapErr(ctx context.Context, foldable Foldable, limit chan struct{}, failFast bool, mapFunc func(context.Context, T) (T, error)) (Foldable, error) {
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	waitGroup := &sync.WaitGroup{}
+	pendingResults := MapToType(List{}, foldable, func(next T) T {
+		return async(waitGroup, limit, func() T {
+			if workCtx.Err() != nil {
+				return itemResult{Skipped: true}
+			}
+			value, err := recoverMapFunc(workCtx, mapFunc, next)
+			if err != nil && failFast {
+				cancel()
+			}
+			return itemResult{Value: value, Err: err}
+		})
+	}).(List)
+	waitGroup.Wait()
+
+	var itemErrors ItemErrors
+	values := List{}
+	for index, pending := range pendingResults {
+		result := (*pending.(*T)).(itemResult)
+		if result.Skipped {
+			continue
+		}
+		if result.Err != nil {
+			itemErrors = append(itemErrors, ItemError{Index: index, Err: result.Err})
+			continue
+		}
+		values = append(values, result.Value)
+	}
+
+	var result Foldable
+	if isAsync(foldable) {
+		// see ParMap, channels need to be fed the results in a go func
+		result = asyncOf(foldable, values)
+	} else {
+		result = MapToType(foldable, values, func(next T) T { return next })
+	}
+	if len(itemErrors) > 0 {
+		return result, itemErrors
+	}
+	return result, ctx.Err()
+}
+
+// recoverMapFunc calls mapFunc, returning a PanicError if it panics
+func recoverMapFunc(ctx context.Context, mapFunc func(context.Context, T) (T, error), item T) (value T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	return mapFunc(ctx, item)
+}
@@ -0,0 +1,97 @@
+package foldable
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var errOdd = errors.New("odd")
+
+func TestListParMapErr(t *testing.T) {
+	expected := List{0, 4}
+	got, err := ParMapErr(List{0, 1, 2, 3}, func(x T) (T, error) {
+		if x.(int) == 3 {
+			panic("three")
+		}
+		if x.(int)%2 == 1 {
+			return nil, errOdd
+		}
+		return x.(int) * 2, nil
+	})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	var itemErrors ItemErrors
+	if !errors.As(err, &itemErrors) || len(itemErrors) != 2 {
+		t.Fatalf("result == %v expected 2 ItemErrors", err)
+	}
+	if itemErrors[0].Index != 1 || !errors.Is(itemErrors[0], errOdd) {
+		t.Errorf("result == %v expected %v", itemErrors[0], errOdd)
+	}
+	var panicError PanicError
+	if itemErrors[1].Index != 3 || !errors.As(itemErrors[1], &panicError) || panicError.Value != "three" {
+		t.Errorf("result == %v expected a PanicError", itemErrors[1])
+	}
+	if !errors.Is(err, errOdd) {
+		t.Errorf("result == %v expected to wrap %v", err, errOdd)
+	}
+}
+
+func TestListParMapErrNoErrors(t *testing.T) {
+	expected := IntFoldable{2, 4, 6}
+	got, err := ParMapErr(IntFoldable{1, 2, 3}, func(x T) (T, error) { return x.(int) * 2, nil })
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestListParMapErrFailFast(t *testing.T) {
+	in := List{}
+	for i := 0; i < 100; i++ {
+		in = append(in, i)
+	}
+	calls := 0
+	_, err := ParMapErrFailFast(context.Background(), in, 1, func(ctx context.Context, x T) (T, error) {
+		calls++
+		if x.(int) == 2 {
+			return nil, errOdd
+		}
+		return x, nil
+	})
+	// with a single worker, nothing after the failing item should start
+	if calls != 3 {
+		t.Errorf("result == %v expected %v", calls, 3)
+	}
+	var itemErrors ItemErrors
+	if !errors.As(err, &itemErrors) || len(itemErrors) != 1 || itemErrors[0].Index != 2 {
+		t.Errorf("result == %v expected item 2 to fail", err)
+	}
+}
+
+func TestListParMapErrFailFastCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err := ParMapErrFailFast(ctx, List{1, 2, 3}, 2, func(ctx context.Context, x T) (T, error) { return x, nil })
+	if err != context.Canceled || !reflect.DeepEqual(List{}, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, context.Canceled)
+	}
+}
+
+func TestChannelParMapErr(t *testing.T) {
+	expected := []int{2, 6}
+	gotChannel, err := ParMapErr(ToChannel(List{1, 2, 3}), func(x T) (T, error) {
+		if x.(int) == 2 {
+			return nil, errOdd
+		}
+		return x.(int) * 2, nil
+	})
+	got := []int{}
+	for x := range gotChannel.(Channel) {
+		got = append(got, x.(int))
+	}
+	if !reflect.DeepEqual(expected, got) || !errors.Is(err, errOdd) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
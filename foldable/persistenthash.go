@@ -0,0 +1,294 @@
+package foldable
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"sort"
+)
+
+// PersistentHash is a hash array mapped trie, like the maps in clojure and scala
+// Hash is a go map, so Append changes it for everything which shares it, including the original passed to Filter or Partition
+// a PersistentHash is never changed, Assoc and Dissoc return a new one which shares all the nodes they didn't touch
+// each node has up to 32 children, chosen by 5 bits of the hash of the key, and only stores the children which exist
+// it folds in key order like Hash, with the same cost of sorting the keys on every fold
+// the zero value is an empty PersistentHash
+type PersistentHash struct {
+	count int
+	root  *hamtNode
+}
+
+// hamtNode stores only the slots with their bit set in the bitmap, in order
+type hamtNode struct {
+	bitmap uint32
+	slots  []hamtSlot
+}
+
+// hamtSlot is either another node, or the entries with one hash
+// there is more than one entry only when different keys have exactly the same hash
+type hamtSlot struct {
+	node    *hamtNode
+	hash    uint32
+	entries []HashEntry
+}
+
+// hashKey is a var so that tests can force collisions
+var hashKey = func(key string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return h.Sum32()
+}
+
+// PersistentHashOf returns a PersistentHash with the same entries as a Hash
+func PersistentHashOf(hash Hash) PersistentHash {
+	result := PersistentHash{}
+	for key, value := range hash {
+		result = result.Assoc(key, value)
+	}
+	return result
+}
+
+// ToHash returns a Hash with the same entries
+func (foldable PersistentHash) ToHash() Hash {
+	result := make(Hash, foldable.count)
+	foldable.root.each(func(entry HashEntry) {
+		result[entry.Key] = entry.Value
+	})
+	return result
+}
+
+// Len returns the number of entries
+func (foldable PersistentHash) Len() int {
+	return foldable.count
+}
+
+// Get returns the value for key, and false if there isn't one
+func (foldable PersistentHash) Get(key string) (T, bool) {
+	hash := hashKey(key)
+	node := foldable.root
+	for shift := uint(0); node != nil; shift += vectorBits {
+		slot, ok := node.slot(hash, shift)
+		if !ok {
+			return nil, false
+		}
+		if slot.node == nil {
+			return slot.get(hash, key)
+		}
+		node = slot.node
+	}
+	return nil, false
+}
+
+// Assoc returns a new PersistentHash with key set to value
+func (foldable PersistentHash) Assoc(key string, value T) PersistentHash {
+	root := foldable.root
+	if root == nil {
+		root = &hamtNode{}
+	}
+	root, added := root.assoc(0, hashKey(key), HashEntry{Key: key, Value: value})
+	if added {
+		foldable.count++
+	}
+	foldable.root = root
+	return foldable
+}
+
+// Dissoc returns a new PersistentHash without key
+func (foldable PersistentHash) Dissoc(key string) PersistentHash {
+	if foldable.root == nil {
+		return foldable
+	}
+	root, removed := foldable.root.dissoc(0, hashKey(key), key)
+	if removed {
+		foldable.count--
+		foldable.root = root
+	}
+	return foldable
+}
+
+// index is where the bit for this hash is in the bitmap, and position is where that slot is stored
+func (node *hamtNode) index(hash uint32, shift uint) (bit uint32, position int) {
+	bit = 1 << ((hash >> shift) & vectorMask)
+	return bit, bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+func (node *hamtNode) slot(hash uint32, shift uint) (hamtSlot, bool) {
+	bit, position := node.index(hash, shift)
+	if node.bitmap&bit == 0 {
+		return hamtSlot{}, false
+	}
+	return node.slots[position], true
+}
+
+// with returns a copy of the node with the slot at position replaced
+func (node *hamtNode) with(position int, slot hamtSlot) *hamtNode {
+	slots := append([]hamtSlot{}, node.slots...)
+	slots[position] = slot
+	return &hamtNode{bitmap: node.bitmap, slots: slots}
+}
+
+func (node *hamtNode) assoc(shift uint, hash uint32, entry HashEntry) (*hamtNode, bool) {
+	bit, position := node.index(hash, shift)
+	if node.bitmap&bit == 0 {
+		slots := make([]hamtSlot, 0, len(node.slots)+1)
+		slots = append(slots, node.slots[:position]...)
+		slots = append(slots, hamtSlot{hash: hash, entries: []HashEntry{entry}})
+		slots = append(slots, node.slots[position:]...)
+		return &hamtNode{bitmap: node.bitmap | bit, slots: slots}, true
+	}
+	slot := node.slots[position]
+	switch {
+	case slot.node != nil:
+		child, added := slot.node.assoc(shift+vectorBits, hash, entry)
+		return node.with(position, hamtSlot{node: child}), added
+	case slot.hash == hash:
+		entries, added := slot.assoc(entry)
+		return node.with(position, hamtSlot{hash: hash, entries: entries}), added
+	}
+	// a different hash in the same place, so they both move down into a new node
+	child := mergeSlots(shift+vectorBits, slot, hamtSlot{hash: hash, entries: []HashEntry{entry}})
+	return node.with(position, hamtSlot{node: child}), true
+}
+
+func mergeSlots(shift uint, a, b hamtSlot) *hamtNode {
+	indexA, indexB := (a.hash>>shift)&vectorMask, (b.hash>>shift)&vectorMask
+	if indexA == indexB {
+		return &hamtNode{bitmap: 1 << indexA, slots: []hamtSlot{{node: mergeSlots(shift+vectorBits, a, b)}}}
+	}
+	if indexA > indexB {
+		a, b = b, a
+		indexA, indexB = indexB, indexA
+	}
+	return &hamtNode{bitmap: 1<<indexA | 1<<indexB, slots: []hamtSlot{a, b}}
+}
+
+func (node *hamtNode) dissoc(shift uint, hash uint32, key string) (*hamtNode, bool) {
+	bit, position := node.index(hash, shift)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+	slot := node.slots[position]
+	if slot.node != nil {
+		child, removed := slot.node.dissoc(shift+vectorBits, hash, key)
+		if !removed {
+			return node, false
+		}
+		if len(child.slots) == 1 && child.slots[0].node == nil {
+			// a node with a single set of entries isn't needed, the entries can move back up
+			return node.with(position, child.slots[0]), true
+		}
+		return node.with(position, hamtSlot{node: child}), true
+	}
+	if slot.hash != hash {
+		return node, false
+	}
+	entries, removed := slot.dissoc(key)
+	if !removed {
+		return node, false
+	}
+	if len(entries) > 0 {
+		return node.with(position, hamtSlot{hash: hash, entries: entries}), true
+	}
+	slots := make([]hamtSlot, 0, len(node.slots)-1)
+	slots = append(slots, node.slots[:position]...)
+	slots = append(slots, node.slots[position+1:]...)
+	return &hamtNode{bitmap: node.bitmap &^ bit, slots: slots}, true
+}
+
+func (slot hamtSlot) get(hash uint32, key string) (T, bool) {
+	if slot.hash != hash {
+		return nil, false
+	}
+	for _, entry := range slot.entries {
+		if entry.Key == key {
+			return entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+// assoc returns a copy of the entries with entry replacing the one with the same key, or added to the end
+func (slot hamtSlot) assoc(entry HashEntry) ([]HashEntry, bool) {
+	entries := append([]HashEntry{}, slot.entries...)
+	for i, existing := range entries {
+		if existing.Key == entry.Key {
+			entries[i] = entry
+			return entries, false
+		}
+	}
+	return append(entries, entry), true
+}
+
+func (slot hamtSlot) dissoc(key string) ([]HashEntry, bool) {
+	for i, existing := range slot.entries {
+		if existing.Key == key {
+			entries := make([]HashEntry, 0, len(slot.entries)-1)
+			entries = append(entries, slot.entries[:i]...)
+			return append(entries, slot.entries[i+1:]...), true
+		}
+	}
+	return slot.entries, false
+}
+
+// each calls f for every entry, in hash order
+func (node *hamtNode) each(f func(HashEntry)) {
+	if node == nil {
+		return
+	}
+	for _, slot := range node.slots {
+		if slot.node != nil {
+			slot.node.each(f)
+			continue
+		}
+		for _, entry := range slot.entries {
+			f(entry)
+		}
+	}
+}
+
+func (foldable PersistentHash) sortedEntries() []HashEntry {
+	// take and drop depend on order, so we need a guaranteed order
+	entries := make([]HashEntry, 0, foldable.count)
+	foldable.root.each(func(entry HashEntry) {
+		entries = append(entries, entry)
+	})
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries
+}
+
+func (foldable PersistentHash) Foldl(init T, foldFunc func(result, next T) T) T {
+	result := init
+	for _, entry := range foldable.sortedEntries() {
+		result = foldFunc(result, entry)
+	}
+	return result
+}
+
+func (foldable PersistentHash) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, entry := range foldable.sortedEntries() {
+		var more bool
+		if result, more = foldFunc(result, entry); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (foldable PersistentHash) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	entries := foldable.sortedEntries()
+	for i := len(entries) - 1; i >= 0; i-- {
+		result = foldFunc(entries[i], result)
+	}
+	return result
+}
+
+func (foldable PersistentHash) Init() Foldable {
+	return PersistentHash{}
+}
+
+func (foldable PersistentHash) Append(item T) Foldable {
+	return foldable.Assoc(item.(HashEntry).Key, item.(HashEntry).Value)
+}
+
+func (foldable PersistentHash) keyOrdered() {}
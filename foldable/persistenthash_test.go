@@ -0,0 +1,102 @@
+package foldable
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestPersistentHashAssocGet(t *testing.T) {
+	hash := PersistentHash{}.Assoc("a", 1).Assoc("b", 2).Assoc("a", 3)
+	if hash.Len() != 2 {
+		t.Errorf("result == %v expected %v", hash.Len(), 2)
+	}
+	if got, ok := hash.Get("a"); !ok || got != 3 {
+		t.Errorf("result == %v expected %v", got, 3)
+	}
+	if got, ok := hash.Get("c"); ok {
+		t.Errorf("result == %v expected nothing", got)
+	}
+}
+
+func TestPersistentHashBranching(t *testing.T) {
+	// with a Hash, Filter would share and change the original map
+	original := PersistentHashOf(Hash{"a": 1, "b": 2})
+	withC := original.Assoc("c", 3)
+	withoutA := original.Dissoc("a")
+	if !reflect.DeepEqual(Hash{"a": 1, "b": 2}, original.ToHash()) {
+		t.Errorf("result == %v expected the original to be unchanged", original.ToHash())
+	}
+	if !reflect.DeepEqual(Hash{"a": 1, "b": 2, "c": 3}, withC.ToHash()) {
+		t.Errorf("result == %v expected %v", withC.ToHash(), Hash{"a": 1, "b": 2, "c": 3})
+	}
+	if !reflect.DeepEqual(Hash{"b": 2}, withoutA.ToHash()) {
+		t.Errorf("result == %v expected %v", withoutA.ToHash(), Hash{"b": 2})
+	}
+}
+
+func TestPersistentHashLarge(t *testing.T) {
+	n := 10000
+	hash := PersistentHash{}
+	for i := 0; i < n; i++ {
+		hash = hash.Assoc(strconv.Itoa(i), i)
+	}
+	for i := 0; i < n; i += 2 {
+		hash = hash.Dissoc(strconv.Itoa(i))
+	}
+	if hash.Len() != n/2 {
+		t.Fatalf("result == %v expected %v", hash.Len(), n/2)
+	}
+	for i := 0; i < n; i++ {
+		got, ok := hash.Get(strconv.Itoa(i))
+		if ok != (i%2 == 1) || (ok && got != i) {
+			t.Fatalf("result == %v, %v for %v", got, ok, i)
+		}
+	}
+}
+
+func TestPersistentHashCollisions(t *testing.T) {
+	previous := hashKey
+	hashKey = func(string) uint32 { return 7 }
+	defer func() { hashKey = previous }()
+	hash := PersistentHash{}.Assoc("a", 1).Assoc("b", 2).Assoc("c", 3).Dissoc("b")
+	if !reflect.DeepEqual(Hash{"a": 1, "c": 3}, hash.ToHash()) {
+		t.Errorf("result == %v expected %v", hash.ToHash(), Hash{"a": 1, "c": 3})
+	}
+	if got, ok := hash.Get("c"); !ok || got != 3 {
+		t.Errorf("result == %v expected %v", got, 3)
+	}
+}
+
+func TestPersistentHashFoldOrder(t *testing.T) {
+	expected := List{HashEntry{"a", 1}, HashEntry{"b", 2}}
+	in := PersistentHashOf(Hash{"c": 3, "a": 1, "b": 2, "d": 4})
+	got := MapToType(List{}, Take(in, 2), func(x T) T { return x })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestPersistentHashFilterPartition(t *testing.T) {
+	in := PersistentHashOf(Hash{"a": 1, "b": -2, "c": 3})
+	pass, fail := Partition(in, func(x T) bool { return x.(HashEntry).Value.(int) > 0 })
+	if !reflect.DeepEqual(Hash{"a": 1, "c": 3}, pass.(PersistentHash).ToHash()) {
+		t.Errorf("result == %v expected %v", pass.(PersistentHash).ToHash(), Hash{"a": 1, "c": 3})
+	}
+	if !reflect.DeepEqual(Hash{"b": -2}, fail.(PersistentHash).ToHash()) {
+		t.Errorf("result == %v expected %v", fail.(PersistentHash).ToHash(), Hash{"b": -2})
+	}
+	if in.Len() != 3 {
+		t.Errorf("result == %v expected the original to be unchanged", in.Len())
+	}
+}
+
+func TestPersistentHashSortBy(t *testing.T) {
+	// a PersistentHash always folds in key order, so the sorted entries come back in a List
+	in := PersistentHashOf(Hash{"a": 2, "b": 1})
+	got, err := SortBy(in, func(a, b T) bool { return a.(HashEntry).Value.(int) < b.(HashEntry).Value.(int) })
+	expected := List{HashEntry{"b", 1}, HashEntry{"a", 2}}
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
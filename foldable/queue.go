@@ -0,0 +1,314 @@
+package foldable
+
+// Deque is a double ended queue in a ring buffer, so pushing and popping at either end is O(1)
+// use PushBack and PopFront for first in first out, or PushBack and PopBack for last in first out
+// it folds from front to back, the order PopFront would return them
+// like Hash, pushing and popping change it for everything which shares it
+// the zero value is an empty Deque
+type Deque struct {
+	ring *ringBuffer
+}
+
+// ringBuffer holds count items starting at head, wrapping around to the start of items
+type ringBuffer struct {
+	items       []T
+	head, count int
+}
+
+// NewDeque returns an empty Deque
+func NewDeque() Deque {
+	return Deque{ring: &ringBuffer{}}
+}
+
+// Len returns the number of items
+func (foldable Deque) Len() int {
+	if foldable.ring == nil {
+		return 0
+	}
+	return foldable.ring.count
+}
+
+// PushBack adds item at the back
+func (foldable Deque) PushBack(item T) Deque {
+	ring := foldable.grow()
+	ring.items[ring.index(ring.count)] = item
+	ring.count++
+	return Deque{ring: ring}
+}
+
+// PushFront adds item at the front
+func (foldable Deque) PushFront(item T) Deque {
+	ring := foldable.grow()
+	ring.head = ring.index(len(ring.items) - 1)
+	ring.items[ring.head] = item
+	ring.count++
+	return Deque{ring: ring}
+}
+
+// PopFront removes and returns the item at the front, and false if there are no items
+func (foldable Deque) PopFront() (T, bool) {
+	item, ok := foldable.PeekFront()
+	if ok {
+		ring := foldable.ring
+		ring.items[ring.head] = nil
+		ring.head = ring.index(1)
+		ring.count--
+	}
+	return item, ok
+}
+
+// PopBack removes and returns the item at the back, and false if there are no items
+func (foldable Deque) PopBack() (T, bool) {
+	item, ok := foldable.PeekBack()
+	if ok {
+		ring := foldable.ring
+		ring.items[ring.index(ring.count-1)] = nil
+		ring.count--
+	}
+	return item, ok
+}
+
+// PeekFront returns the item at the front without removing it, and false if there are no items
+func (foldable Deque) PeekFront() (T, bool) {
+	if foldable.Len() == 0 {
+		return nil, false
+	}
+	return foldable.ring.items[foldable.ring.head], true
+}
+
+// PeekBack returns the item at the back without removing it, and false if there are no items
+func (foldable Deque) PeekBack() (T, bool) {
+	if foldable.Len() == 0 {
+		return nil, false
+	}
+	return foldable.ring.items[foldable.ring.index(foldable.ring.count-1)], true
+}
+
+// index is where the item offset from the front is stored
+func (ring *ringBuffer) index(offset int) int {
+	return (ring.head + offset) % len(ring.items)
+}
+
+// grow makes sure there is room for one more item, doubling the buffer when it is full
+func (foldable Deque) grow() *ringBuffer {
+	ring := foldable.ring
+	if ring == nil {
+		ring = &ringBuffer{}
+	}
+	if ring.count < len(ring.items) {
+		return ring
+	}
+	items := make([]T, max(2*len(ring.items), 8))
+	for i := 0; i < ring.count; i++ {
+		items[i] = ring.items[ring.index(i)]
+	}
+	ring.items, ring.head = items, 0
+	return ring
+}
+
+func (foldable Deque) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.FoldlWhile(init, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (foldable Deque) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for i := 0; i < foldable.Len(); i++ {
+		var more bool
+		if result, more = foldFunc(result, foldable.ring.items[foldable.ring.index(i)]); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (foldable Deque) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	for i := foldable.Len() - 1; i >= 0; i-- {
+		result = foldFunc(foldable.ring.items[foldable.ring.index(i)], result)
+	}
+	return result
+}
+
+func (foldable Deque) Init() Foldable {
+	return NewDeque()
+}
+
+func (foldable Deque) Append(item T) Foldable {
+	return foldable.PushBack(item)
+}
+
+// Queue is first in first out, Push adds at the back and Pop removes from the front
+// it folds in the order Pop would return them
+// the zero value is an empty Queue
+type Queue struct {
+	deque Deque
+}
+
+// NewQueue returns an empty Queue
+func NewQueue() Queue {
+	return Queue{deque: NewDeque()}
+}
+
+// Len returns the number of items
+func (foldable Queue) Len() int {
+	return foldable.deque.Len()
+}
+
+// Push adds item at the back
+func (foldable Queue) Push(item T) Queue {
+	return Queue{deque: foldable.deque.PushBack(item)}
+}
+
+// Pop removes and returns the item at the front, and false if there are no items
+func (foldable Queue) Pop() (T, bool) {
+	return foldable.deque.PopFront()
+}
+
+// Peek returns the item at the front without removing it, and false if there are no items
+func (foldable Queue) Peek() (T, bool) {
+	return foldable.deque.PeekFront()
+}
+
+func (foldable Queue) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.deque.Foldl(init, foldFunc)
+}
+
+func (foldable Queue) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	return foldable.deque.FoldlWhile(init, foldFunc)
+}
+
+func (foldable Queue) Foldr(init T, foldFunc func(next, result T) T) T {
+	return foldable.deque.Foldr(init, foldFunc)
+}
+
+func (foldable Queue) Init() Foldable {
+	return NewQueue()
+}
+
+func (foldable Queue) Append(item T) Foldable {
+	return foldable.Push(item)
+}
+
+// PriorityQueue is a binary heap, so Pop always removes the item which is first by less
+// Push and Pop are O(log n)
+// it folds in the order Pop would return them, by popping from a copy, so a whole fold is O(n log n)
+// equal items have no guaranteed order between them
+// the zero value is an empty PriorityQueue which pops in NaturalLess order
+type PriorityQueue struct {
+	heap *binaryHeap
+}
+
+// binaryHeap keeps every item before both of its children, which are at 2i+1 and 2i+2
+type binaryHeap struct {
+	items []T
+	less  Less
+}
+
+// NewPriorityQueue returns an empty PriorityQueue which pops in the order from less
+func NewPriorityQueue(less Less) PriorityQueue {
+	return PriorityQueue{heap: &binaryHeap{less: less}}
+}
+
+// Len returns the number of items
+func (foldable PriorityQueue) Len() int {
+	if foldable.heap == nil {
+		return 0
+	}
+	return len(foldable.heap.items)
+}
+
+// Push adds item
+func (foldable PriorityQueue) Push(item T) PriorityQueue {
+	if foldable.heap == nil {
+		foldable = NewPriorityQueue(NaturalLess)
+	}
+	foldable.heap.push(item)
+	return foldable
+}
+
+// Pop removes and returns the first item by less, and false if there are no items
+func (foldable PriorityQueue) Pop() (T, bool) {
+	if foldable.Len() == 0 {
+		return nil, false
+	}
+	return foldable.heap.pop(), true
+}
+
+// Peek returns the first item by less without removing it, and false if there are no items
+func (foldable PriorityQueue) Peek() (T, bool) {
+	if foldable.Len() == 0 {
+		return nil, false
+	}
+	return foldable.heap.items[0], true
+}
+
+func (heap *binaryHeap) push(item T) {
+	heap.items = append(heap.items, item)
+	for i := len(heap.items) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !heap.less(heap.items[i], heap.items[parent]) {
+			break
+		}
+		heap.items[i], heap.items[parent] = heap.items[parent], heap.items[i]
+		i = parent
+	}
+}
+
+func (heap *binaryHeap) pop() T {
+	last := len(heap.items) - 1
+	result := heap.items[0]
+	heap.items[0] = heap.items[last]
+	heap.items[last] = nil
+	heap.items = heap.items[:last]
+	for i := 0; ; {
+		first := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < last && heap.less(heap.items[child], heap.items[first]) {
+				first = child
+			}
+		}
+		if first == i {
+			break
+		}
+		heap.items[i], heap.items[first] = heap.items[first], heap.items[i]
+		i = first
+	}
+	return result
+}
+
+func (foldable PriorityQueue) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.FoldlWhile(init, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+// FoldlWhile only pops as many items as it needs, so Take of a few is cheaper than a whole fold
+func (foldable PriorityQueue) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	if foldable.Len() == 0 {
+		return result
+	}
+	popped := &binaryHeap{items: append([]T{}, foldable.heap.items...), less: foldable.heap.less}
+	for len(popped.items) > 0 {
+		var more bool
+		if result, more = foldFunc(result, popped.pop()); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (foldable PriorityQueue) Init() Foldable {
+	if foldable.heap == nil {
+		return PriorityQueue{}
+	}
+	return NewPriorityQueue(foldable.heap.less)
+}
+
+func (foldable PriorityQueue) Append(item T) Foldable {
+	return foldable.Push(item)
+}
+
+func (foldable PriorityQueue) keyOrdered() {}
@@ -0,0 +1,137 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDequeBothEnds(t *testing.T) {
+	deque := Deque{}
+	for i := 1; i <= 10; i++ {
+		deque = deque.PushBack(i).PushFront(-i)
+	}
+	expected := []T{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if got := ToList(deque); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	front, _ := deque.PopFront()
+	back, _ := deque.PopBack()
+	if front != -10 || back != 10 || deque.Len() != 18 {
+		t.Errorf("result == %v, %v, %v expected -10, 10, 18", front, back, deque.Len())
+	}
+	reversed := Foldr(deque, []T{}, func(next, result T) T { return append(result.([]T), next) })
+	if first := reversed.([]T)[0]; first != 9 {
+		t.Errorf("result == %v expected %v", first, 9)
+	}
+}
+
+func TestDequeWrapsAround(t *testing.T) {
+	// popping from the front and pushing at the back moves the items around the ring without growing it
+	deque := NewDeque()
+	for i := 0; i < 8; i++ {
+		deque = deque.PushBack(i)
+	}
+	for i := 8; i < 20; i++ {
+		deque.PopFront()
+		deque = deque.PushBack(i)
+	}
+	expected := []T{12, 13, 14, 15, 16, 17, 18, 19}
+	if got := ToList(deque); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if len(deque.ring.items) != 8 {
+		t.Errorf("result == %v expected %v", len(deque.ring.items), 8)
+	}
+}
+
+func TestDequeEmpty(t *testing.T) {
+	if item, ok := (Deque{}).PopBack(); ok {
+		t.Errorf("result == %v expected nothing", item)
+	}
+	if item, ok := (Queue{}).Pop(); ok {
+		t.Errorf("result == %v expected nothing", item)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	queue := Queue{}.Push(1).Push(2).Push(3)
+	first, _ := queue.Pop()
+	queue = queue.Push(4)
+	if first != 1 {
+		t.Errorf("result == %v expected %v", first, 1)
+	}
+	expected := []T{2, 3, 4}
+	if got := ToList(queue); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	doubled := ToList(Map(queue, func(x T) T { return x.(int) * 2 }))
+	if !reflect.DeepEqual([]T{4, 6, 8}, doubled) {
+		t.Errorf("result == %v expected %v", doubled, []T{4, 6, 8})
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	queue := NewPriorityQueue(intLess)
+	for _, item := range []int{5, 1, 4, 1, 5, 9, 2, 6} {
+		queue = queue.Push(item)
+	}
+	expected := []T{1, 1, 2, 4, 5, 5, 6, 9}
+	if got := ToList(queue); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	// folding doesn't pop anything
+	if queue.Len() != 8 {
+		t.Errorf("result == %v expected %v", queue.Len(), 8)
+	}
+	for _, want := range expected {
+		if got, ok := queue.Pop(); !ok || got != want {
+			t.Errorf("result == %v expected %v", got, want)
+		}
+	}
+	if item, ok := queue.Pop(); ok {
+		t.Errorf("result == %v expected nothing", item)
+	}
+}
+
+func TestPriorityQueueTakeKeepsOrder(t *testing.T) {
+	queue := NewPriorityQueue(func(a, b T) bool { return a.(int) > b.(int) })
+	for i := 0; i < 100; i++ {
+		queue.Push(i)
+	}
+	expected := []T{99, 98, 97}
+	if got := ToList(Take(queue, 3)); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestPriorityQueueZeroValue(t *testing.T) {
+	empty := PriorityQueue{}
+	if empty.Len() != 0 || len(ToList(empty)) != 0 {
+		t.Errorf("result == %v expected an empty queue", ToList(empty))
+	}
+	if item, ok := empty.Peek(); ok {
+		t.Errorf("result == %v expected nothing", item)
+	}
+	if item, ok := empty.Pop(); ok {
+		t.Errorf("result == %v expected nothing", item)
+	}
+	// without a less, it uses NaturalLess
+	queue := PriorityQueue{}.Push(3).Push(1).Push(2)
+	expected := []T{1, 2, 3}
+	if got := ToList(queue); !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if got := ToList(Filter(PriorityQueue{}, func(T) bool { return true })); len(got) != 0 {
+		t.Errorf("result == %v expected nothing", got)
+	}
+}
+
+func TestPriorityQueueSortBy(t *testing.T) {
+	// a PriorityQueue always folds in its own order, so the sorted items come back in a List
+	queue := NewPriorityQueue(intLess).Push(2).Push(1).Push(3)
+	got, err := SortBy(queue, Descending(intLess))
+	expected := List{3, 2, 1}
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,37 @@
+package foldable
+
+// Scan is a left fold which keeps every intermediate result, starting with init
+// haskell scanl
+// scanl f z [x1, x2, ...] == [z, z `f` x1, (z `f` x1) `f` x2, ...]
+// the results are in the same kind of Foldable as the input, so they must be items it can hold
+// on a Channel each result is sent as soon as it is folded, so this works on an unbounded channel
+func Scan(foldable Foldable, init T, foldFunc func(result, next T) T) Foldable {
+	if seq, ok := foldable.(Seq); ok {
+		return seq.Scan(init, foldFunc)
+	}
+	return combineInto(foldable.Init(), func(result Foldable) Foldable {
+		accumulator := init
+		return foldInto(result.Append(accumulator), foldable, func(result Foldable, next T) Foldable {
+			accumulator = foldFunc(accumulator, next)
+			return result.Append(accumulator)
+		})
+	})
+}
+
+// Scanr is a right fold which keeps every intermediate result, ending with init
+// haskell scanr
+// scanr f z [x1, x2] == [x1 `f` (x2 `f` z), x2 `f` z, z]
+// like Foldr, it needs every item before it can start, so a Channel must be finite
+func Scanr(foldable Foldable, init T, foldFunc func(next, result T) T) Foldable {
+	return combineInto(foldable.Init(), func(result Foldable) Foldable {
+		// the results are built from the end, so they're reversed when appended
+		accumulators := Foldr(foldable, []T{init}, func(next, result T) T {
+			accumulators := result.([]T)
+			return append(accumulators, foldFunc(next, accumulators[len(accumulators)-1]))
+		}).([]T)
+		for i := len(accumulators) - 1; i >= 0; i-- {
+			result = result.Append(accumulators[i])
+		}
+		return result
+	})
+}
@@ -0,0 +1,54 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func add(result, next T) T {
+	return result.(int) + next.(int)
+}
+
+func TestListScan(t *testing.T) {
+	expected := List{0, 1, 3, 6}
+	got := Scan(List{1, 2, 3}, 0, add)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestIntFoldableScanEmpty(t *testing.T) {
+	expected := IntFoldable{10}
+	got := Scan(IntFoldable{}, 10, add)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListScanr(t *testing.T) {
+	// 1 - (2 - (3 - 0)), 2 - (3 - 0), 3 - 0, 0
+	expected := List{2, -1, 3, 0}
+	got := Scanr(List{1, 2, 3}, 0, func(next, result T) T { return next.(int) - result.(int) })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelScanUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	// running totals of 0, 1, 2, 3
+	expected := []T{0, 0, 1, 3, 6}
+	got := ToList(Take(Scan(naturals(done), 0, add), 5))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelScanr(t *testing.T) {
+	expected := []T{6, 5, 3, 0}
+	got := ToList(Scanr(ToChannel(List{1, 2, 3}), 0, add))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,219 @@
+package foldable
+
+// Seq is a lazy sequence, pulled one item at a time, without any go funcs
+// Channel is also lazy, but needs a go func to produce the items and synchronisation for every item
+// each call of a Seq starts a new Iterator, so a Seq can be folded more than once,
+// unless it was made from something which can only be read once, like a Channel
+// Map, Filter, Take, Drop, Scan, FlatMap and Concat on a Seq return another Seq, and nothing is done until it is folded
+// any other function with a Seq result builds it in a List, see seqBuilder
+type Seq func() Iterator
+
+// Iterator returns the next item each time it's called, and false once there are none left
+type Iterator func() (T, bool)
+
+// ToSeq returns a Seq of the items in any foldable
+// a List or IntFoldable is read by index, a Channel one item at a time,
+// and anything else is converted to a list when the Seq is first folded
+func ToSeq(foldable Foldable) Seq {
+	if seq, ok := foldable.(Seq); ok {
+		return seq
+	}
+	return func() Iterator {
+		return pull(foldable)
+	}
+}
+
+// SeqOf returns a Seq of the given items
+func SeqOf(items ...T) Seq {
+	return ToSeq(List(items))
+}
+
+// Iterate returns the unbounded Seq of init, f(init), f(f(init)) and so on
+func Iterate(init T, f func(T) T) Seq {
+	return func() Iterator {
+		next := init
+		return func() (T, bool) {
+			item := next
+			next = f(next)
+			return item, true
+		}
+	}
+}
+
+func (seq Seq) Foldl(init T, foldFunc func(result, next T) T) T {
+	result := init
+	next := seq()
+	for item, ok := next(); ok; item, ok = next() {
+		result = foldFunc(result, item)
+	}
+	return result
+}
+
+func (seq Seq) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	next := seq()
+	for item, ok := next(); ok; item, ok = next() {
+		var more bool
+		if result, more = foldFunc(result, item); !more {
+			break
+		}
+	}
+	return result
+}
+
+func (seq Seq) Init() Foldable {
+	return SeqOf()
+}
+
+// Append returns a new Seq with the item after all of the others
+// each Append adds another layer to every pull, so a long Seq should be made with ToSeq instead
+// the functions in this package never build a Seq with Append, see seqBuilder
+func (seq Seq) Append(item T) Foldable {
+	return Seq(func() Iterator {
+		next := seq()
+		done := false
+		return func() (T, bool) {
+			if done {
+				return nil, false
+			}
+			if x, ok := next(); ok {
+				return x, true
+			}
+			done = true
+			return item, true
+		}
+	})
+}
+
+// seqBuilder returns where to build a result of the same kind as target, and how to turn it back into that kind
+// a Seq result is built in a List and turned back with ToSeq, rather than with an Append for every item
+// anything else is built in target, and is already the right kind
+func seqBuilder(target Foldable) (Foldable, func(Foldable) Foldable) {
+	if _, ok := target.(Seq); ok {
+		return List{}, func(built Foldable) Foldable { return ToSeq(built) }
+	}
+	return target, func(built Foldable) Foldable { return built }
+}
+
+// Concat is the lazy version of Concat, the items of other are pulled once all of these have been
+func (seq Seq) Concat(other Foldable) Seq {
+	return func() Iterator {
+		next := seq()
+		otherStarted := false
+		return func() (T, bool) {
+			if item, ok := next(); ok {
+				return item, true
+			}
+			if !otherStarted {
+				otherStarted = true
+				next = pull(other)
+				return next()
+			}
+			return nil, false
+		}
+	}
+}
+
+// Scan is the lazy version of Scan, so it works on an unbounded Seq like Iterate
+func (seq Seq) Scan(init T, foldFunc func(result, next T) T) Seq {
+	return func() Iterator {
+		next := seq()
+		accumulator := init
+		started := false
+		return func() (T, bool) {
+			if !started {
+				started = true
+				return accumulator, true
+			}
+			item, ok := next()
+			if !ok {
+				return nil, false
+			}
+			accumulator = foldFunc(accumulator, item)
+			return accumulator, true
+		}
+	}
+}
+
+// FlatMap is the lazy version of FlatMap, each inner foldable is only made when it is reached
+func (seq Seq) FlatMap(mapFunc func(T) Foldable) Seq {
+	return func() Iterator {
+		outer := seq()
+		inner := func() (T, bool) { return nil, false }
+		return func() (T, bool) {
+			for {
+				if item, ok := inner(); ok {
+					return item, true
+				}
+				next, ok := outer()
+				if !ok {
+					return nil, false
+				}
+				inner = pull(mapFunc(next))
+			}
+		}
+	}
+}
+
+// Map is the lazy version of Map
+func (seq Seq) Map(mapFunc func(T) T) Seq {
+	return func() Iterator {
+		next := seq()
+		return func() (T, bool) {
+			item, ok := next()
+			if !ok {
+				return nil, false
+			}
+			return mapFunc(item), true
+		}
+	}
+}
+
+// Filter is the lazy version of Filter
+func (seq Seq) Filter(filterFunc func(T) bool) Seq {
+	return func() Iterator {
+		next := seq()
+		return func() (T, bool) {
+			for item, ok := next(); ok; item, ok = next() {
+				if filterFunc(item) {
+					return item, true
+				}
+			}
+			return nil, false
+		}
+	}
+}
+
+// Take is the lazy version of Take, it never pulls more than number items
+func (seq Seq) Take(number int) Seq {
+	return func() Iterator {
+		next := seq()
+		count := 0
+		return func() (T, bool) {
+			if count >= number {
+				return nil, false
+			}
+			count++
+			return next()
+		}
+	}
+}
+
+// Drop is the lazy version of Drop
+func (seq Seq) Drop(number int) Seq {
+	return func() Iterator {
+		next := seq()
+		dropped := false
+		return func() (T, bool) {
+			if !dropped {
+				dropped = true
+				for i := 0; i < number; i++ {
+					if _, ok := next(); !ok {
+						return nil, false
+					}
+				}
+			}
+			return next()
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSeqFoldl(t *testing.T) {
+	got := SeqOf(1, 2, 3).Foldl(0, add)
+	if got != 6 {
+		t.Errorf("result == %v expected %v", got, 6)
+	}
+}
+
+func TestSeqAppend(t *testing.T) {
+	expected := []T{1, 2, 3}
+	got := ToList(SeqOf(1).Append(2).Append(3))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestSeqIsLazy(t *testing.T) {
+	calls := 0
+	seq := Take(Filter(Map(Iterate(0, func(x T) T { return x.(int) + 1 }), func(x T) T {
+		calls++
+		return x.(int) * 2
+	}), func(x T) bool { return x.(int)%3 == 0 }), 3)
+	if _, ok := seq.(Seq); !ok || calls != 0 {
+		t.Errorf("result == %T after %v calls expected an unfolded Seq", seq, calls)
+	}
+	expected := []T{0, 6, 12}
+	got := ToList(seq)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	// only as many items as were needed for the three results
+	if calls != 7 {
+		t.Errorf("result == %v expected %v", calls, 7)
+	}
+}
+
+func TestSeqDrop(t *testing.T) {
+	expected := []T{3, 4}
+	got := ToList(Drop(SeqOf(1, 2, 3, 4), 2))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if got := ToList(Drop(SeqOf(1), 2)); len(got) != 0 {
+		t.Errorf("result == %v expected %v", got, []T{})
+	}
+}
+
+func TestSeqFoldedTwice(t *testing.T) {
+	expected := []T{1, 2}
+	seq := Distinct(SeqOf(1, 2, 1))
+	ToList(seq)
+	got := ToList(seq)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestSeqConversions(t *testing.T) {
+	expected := List{2, 4, 6}
+	seq := Map(ToSeq(ToChannel(List{1, 2, 3})), func(x T) T { return x.(int) * 2 })
+	channel := ToChannel(seq)
+	got := MapToType(List{}, ToSeq(channel), func(x T) T { return x })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestSeqAny(t *testing.T) {
+	naturals := Iterate(0, func(x T) T { return x.(int) + 1 })
+	if !Any(naturals, func(x T) bool { return x.(int) > 100 }) {
+		t.Errorf("result == %v expected %v", false, true)
+	}
+}
+
+func TestSeqLazyScanFlatMapConcat(t *testing.T) {
+	naturals := Iterate(1, func(x T) T { return x.(int) + 1 })
+	got := ToList(Take(Scan(naturals, 0, add), 4))
+	if !reflect.DeepEqual([]T{0, 1, 3, 6}, got) {
+		t.Errorf("result == %v expected %v", got, []T{0, 1, 3, 6})
+	}
+	got = ToList(Take(FlatMap(naturals, func(x T) Foldable { return List{x, x} }), 3))
+	if !reflect.DeepEqual([]T{1, 1, 2}, got) {
+		t.Errorf("result == %v expected %v", got, []T{1, 1, 2})
+	}
+	got = ToList(Take(Concat(SeqOf(0), naturals), 3))
+	if !reflect.DeepEqual([]T{0, 1, 2}, got) {
+		t.Errorf("result == %v expected %v", got, []T{0, 1, 2})
+	}
+}
+
+func TestSeqResultsAreFlat(t *testing.T) {
+	// each Append to a Seq adds a layer, so building a long result that way would take quadratic time
+	items := make(List, 20000)
+	for i := range items {
+		items[i] = i
+	}
+	results := []Foldable{
+		Scan(ToSeq(items), 0, add),
+		Union(ToSeq(items), SeqOf()),
+		Reverse(ToSeq(items)),
+		GroupBy(ToSeq(items), func(T) string { return "all" })["all"].(Foldable),
+	}
+	pass, _ := Partition(ToSeq(items), func(T) bool { return true })
+	sorted, _ := SortBy(ToSeq(items), intLess)
+	results = append(results, pass, sorted)
+	start := time.Now()
+	for _, result := range results {
+		if _, ok := result.(Seq); !ok {
+			t.Errorf("result == %T expected a Seq", result)
+		}
+		Length(result)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("folding the results took %v", elapsed)
+	}
+}
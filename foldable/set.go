@@ -0,0 +1,112 @@
+package foldable
+
+// Set is a Foldable where each item is only kept once, so Append of an item already there does nothing
+// items must be comparable with ==, as they are the keys of a go map
+// it folds in the order the items were first added, as items of any type can't always be sorted
+// like OrderedHash, it is a linkedIndex, keyed by the item itself
+// Append and Remove change it for everything which shares it
+// but Union, Intersection, Difference and SymmetricDifference always return a new Set
+// the zero value is an empty Set
+type Set struct {
+	items *linkedIndex[T]
+}
+
+// SetOf returns a Set of the items, without any repeats
+func SetOf(items ...T) Set {
+	result := NewSet()
+	for _, item := range items {
+		result = result.Add(item)
+	}
+	return result
+}
+
+// NewSet returns an empty Set
+func NewSet() Set {
+	return Set{items: newLinkedIndex[T]()}
+}
+
+// Len returns the number of items
+func (foldable Set) Len() int {
+	return foldable.items.len()
+}
+
+// Contains reports whether item is in the Set
+func (foldable Set) Contains(item T) bool {
+	_, ok := foldable.items.get(item)
+	return ok
+}
+
+// Add adds item at the end, if it isn't already there
+func (foldable Set) Add(item T) Set {
+	if foldable.items == nil {
+		foldable = NewSet()
+	}
+	if !foldable.Contains(item) {
+		foldable.items.set(item, item)
+	}
+	return foldable
+}
+
+// Remove removes item, if it is there
+func (foldable Set) Remove(item T) Set {
+	foldable.items.remove(item)
+	return foldable
+}
+
+// Union returns the items in either Set, in this order then the order of other
+func (foldable Set) Union(other Set) Set {
+	always := func(T) bool { return true }
+	return other.addAll(foldable.addAll(NewSet(), always), always)
+}
+
+// Intersection returns the items in both sets, in this order
+func (foldable Set) Intersection(other Set) Set {
+	return foldable.addAll(NewSet(), other.Contains)
+}
+
+// Difference returns the items which are not in other, in this order
+func (foldable Set) Difference(other Set) Set {
+	return foldable.addAll(NewSet(), func(item T) bool { return !other.Contains(item) })
+}
+
+// SymmetricDifference returns the items which are only in one of the sets, in this order then the order of other
+func (foldable Set) SymmetricDifference(other Set) Set {
+	return other.addAll(foldable.Difference(other), func(item T) bool { return !foldable.Contains(item) })
+}
+
+// IsSubset reports whether every item is also in other
+func (foldable Set) IsSubset(other Set) bool {
+	return All(foldable, other.Contains)
+}
+
+// addAll adds the items which pass filterFunc to result
+func (foldable Set) addAll(result Set, filterFunc func(T) bool) Set {
+	return foldable.Foldl(result, func(result, next T) T {
+		if filterFunc(next) {
+			return result.(Set).Add(next)
+		}
+		return result
+	}).(Set)
+}
+
+func (foldable Set) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.FoldlWhile(init, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (foldable Set) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	return foldable.items.foldlWhile(init, foldFunc)
+}
+
+func (foldable Set) Foldr(init T, foldFunc func(next, result T) T) T {
+	return foldable.items.foldr(init, foldFunc)
+}
+
+func (foldable Set) Init() Foldable {
+	return NewSet()
+}
+
+func (foldable Set) Append(item T) Foldable {
+	return foldable.Add(item)
+}
@@ -0,0 +1,66 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetAppendIdempotent(t *testing.T) {
+	in := SetOf(3, 1, 3, 2, 1).Append(2)
+	expected := []T{3, 1, 2}
+	got := ToList(in)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a, b := SetOf(1, 2, 3), SetOf(4, 3, 2)
+	tests := []struct {
+		name     string
+		got      Set
+		expected []T
+	}{
+		{"union", a.Union(b), []T{1, 2, 3, 4}},
+		{"intersection", a.Intersection(b), []T{2, 3}},
+		{"difference", a.Difference(b), []T{1}},
+		{"symmetric difference", a.SymmetricDifference(b), []T{1, 4}},
+	}
+	for _, test := range tests {
+		if got := ToList(test.got); !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("%v result == %v expected %v", test.name, got, test.expected)
+		}
+	}
+	if !reflect.DeepEqual([]T{1, 2, 3}, ToList(a)) {
+		t.Errorf("result == %v expected the original to be unchanged", ToList(a))
+	}
+}
+
+func TestSetIsSubset(t *testing.T) {
+	if !SetOf(1, 2).IsSubset(SetOf(2, 1, 3)) {
+		t.Errorf("result == false expected true")
+	}
+	if SetOf(1, 4).IsSubset(SetOf(1, 2, 3)) {
+		t.Errorf("result == true expected false")
+	}
+	if !(Set{}).IsSubset(Set{}) {
+		t.Errorf("result == false expected true")
+	}
+}
+
+func TestSetFilterMapPartition(t *testing.T) {
+	in := SetOf(1, 2, 3, 4)
+	evens := Filter(in, func(x T) bool { return x.(int)%2 == 0 })
+	if !reflect.DeepEqual([]T{2, 4}, ToList(evens)) {
+		t.Errorf("result == %v expected %v", ToList(evens), []T{2, 4})
+	}
+	// mapping to the same item again only keeps it once
+	halves := Map(in, func(x T) T { return x.(int) / 2 })
+	if !reflect.DeepEqual([]T{0, 1, 2}, ToList(halves)) {
+		t.Errorf("result == %v expected %v", ToList(halves), []T{0, 1, 2})
+	}
+	pass, fail := Partition(in, func(x T) bool { return x.(int) > 2 })
+	if !pass.(Set).Contains(3) || !fail.(Set).Contains(1) || pass.(Set).Len() != 2 {
+		t.Errorf("result == %v, %v expected [3 4], [1 2]", ToList(pass), ToList(fail))
+	}
+}
@@ -0,0 +1,70 @@
+package foldable
+
+// Chunk splits the items into groups of n, the last group has whatever is left over
+// each group is the same kind of Foldable as the input
+// the groups are in a Channel if the input is a Channel, or any other Async, otherwise a List, as most Foldables can't hold other Foldables
+// on a Channel each chunk is sent as soon as it is full, so this can batch an unbounded channel
+func Chunk(foldable Foldable, n int) Foldable {
+	return Sliding(foldable, n, n)
+}
+
+// Window returns every run of size consecutive items, the same as Sliding with a step of 1
+// every window has size items, so there are none if there are fewer items than that
+func Window(foldable Foldable, size int) Foldable {
+	return Sliding(foldable, size, 1)
+}
+
+// Sliding returns a group of size items starting at every step items, see Chunk for the kinds of the result
+// a step smaller than size overlaps the groups, and a larger one skips items between them
+// when the input runs out with a step of at least size, like Chunk, whatever is left is a final shorter group
+// with a smaller step the groups overlap, so every group has size items and anything left is already in one, or dropped
+func Sliding(foldable Foldable, size, step int) Foldable {
+	if size < 1 {
+		size = 1
+	}
+	if step < 1 {
+		step = 1
+	}
+	group := func(items []T) Foldable {
+		if isAsync(foldable) {
+			// a copy, as the buffer is reused
+			return asyncOf(foldable, append(List{}, items...))
+		}
+		return MapToType(foldable, List(items), func(next T) T { return next })
+	}
+	var target Foldable = List{}
+	if isAsync(foldable) {
+		target = foldable.Init()
+	}
+	return combineInto(target, func(result Foldable) Foldable {
+		buffer := []T{}
+		// fresh is how many of the buffered items aren't in a group yet
+		fresh := 0
+		// skip is how many items are between the end of one group and the start of the next
+		skip := 0
+		result = foldInto(result, foldable, func(result Foldable, next T) Foldable {
+			if skip > 0 {
+				skip--
+				return result
+			}
+			buffer = append(buffer, next)
+			fresh++
+			if len(buffer) < size {
+				return result
+			}
+			result = result.Append(group(buffer))
+			fresh = 0
+			if step < size {
+				buffer = append([]T{}, buffer[step:]...)
+			} else {
+				buffer = []T{}
+				skip = step - size
+			}
+			return result
+		})
+		if fresh > 0 && step >= size {
+			result = result.Append(group(buffer))
+		}
+		return result
+	})
+}
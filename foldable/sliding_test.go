@@ -0,0 +1,73 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListChunk(t *testing.T) {
+	expected := List{List{1, 2}, List{3, 4}, List{5}}
+	got := Chunk(List{1, 2, 3, 4, 5}, 2)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestIntFoldableWindow(t *testing.T) {
+	expectedWindows := List{IntFoldable{1, 2, 3}, IntFoldable{2, 3, 4}}
+	got := Window(IntFoldable{1, 2, 3, 4}, 3)
+	if !reflect.DeepEqual(expectedWindows, got) {
+		t.Errorf("result == %v expected %v", got, expectedWindows)
+	}
+}
+
+func TestListSliding(t *testing.T) {
+	tests := []struct {
+		size, step int
+		expected   List
+	}{
+		{2, 1, List{List{1, 2}, List{2, 3}, List{3, 4}, List{4, 5}}},
+		{3, 2, List{List{1, 2, 3}, List{3, 4, 5}}},
+		{2, 3, List{List{1, 2}, List{4, 5}}},
+		{3, 3, List{List{1, 2, 3}, List{4, 5}}},
+		{6, 1, List{}},
+		{2, 2, List{List{1, 2}, List{3, 4}, List{5}}},
+	}
+	for _, test := range tests {
+		got := Sliding(List{1, 2, 3, 4, 5}, test.size, test.step)
+		if !reflect.DeepEqual(test.expected, got) {
+			t.Errorf("Sliding(%v, %v) result == %v expected %v", test.size, test.step, got, test.expected)
+		}
+	}
+	// a window is never shorter than size
+	if got := Window(List{1, 2}, 3); !reflect.DeepEqual(List{}, got) {
+		t.Errorf("result == %v expected %v", got, List{})
+	}
+	if got := Sliding(List{1, 2, 3, 4, 5, 6}, 3, 2); !reflect.DeepEqual(List{List{1, 2, 3}, List{3, 4, 5}}, got) {
+		t.Errorf("result == %v expected %v", got, List{List{1, 2, 3}, List{3, 4, 5}})
+	}
+}
+
+func TestChannelChunk(t *testing.T) {
+	expected := [][]T{{1, 2}, {3, 4}, {5}}
+	got := [][]T{}
+	for chunk := range Chunk(ToChannel(List{1, 2, 3, 4, 5}), 2).(Channel) {
+		got = append(got, ToList(chunk.(Channel)))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelChunkUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	expected := [][]T{{0, 1, 2}, {3, 4, 5}}
+	got := [][]T{}
+	for chunk := range Take(Chunk(naturals(done), 3), 2).(Channel) {
+		got = append(got, ToList(chunk.(Channel)))
+	}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,162 @@
+package foldable
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Less reports whether a should be sorted before b
+type Less func(a, b T) bool
+
+// ErrTooManyToSort is returned when a Channel, or any other Async, has more items than the limit
+// sorting needs every item before it can return any, so an unbounded channel would never finish
+var ErrTooManyToSort = errors.New("foldable: too many items to sort")
+
+// DefaultMaxSortItems is how many items will be read from a Channel before giving up on sorting it
+// SortByN, SortStableByN and SortOnN take a different limit
+const DefaultMaxSortItems = 1000000
+
+// SortBy returns the items sorted by less, in the same kind of Foldable
+// a Hash, PriorityQueue, or any other Foldable which always folds in its own order, is sorted into a List
+// a Channel, or any other Async, is read in full first, and the error is ErrTooManyToSort if it has more than DefaultMaxSortItems
+func SortBy(foldable Foldable, less Less) (Foldable, error) {
+	return SortByN(foldable, less, DefaultMaxSortItems)
+}
+
+// SortByN is SortBy, but gives up on a Channel, or any other Async, with more than maxItems items
+func SortByN(foldable Foldable, less Less, maxItems int) (Foldable, error) {
+	return sortItems(foldable, maxItems, func(items []T) {
+		sort.Slice(items, func(i, j int) bool { return less(items[i], items[j]) })
+	})
+}
+
+// SortStableBy is SortBy, but equal items stay in the order they were in
+func SortStableBy(foldable Foldable, less Less) (Foldable, error) {
+	return SortStableByN(foldable, less, DefaultMaxSortItems)
+}
+
+// SortStableByN is SortStableBy with a limit, like SortByN
+func SortStableByN(foldable Foldable, less Less, maxItems int) (Foldable, error) {
+	return sortItems(foldable, maxItems, func(items []T) {
+		sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+	})
+}
+
+// SortOn is a stable sort on the natural order of the key from keyFunc, see NaturalLess
+// keyFunc is only called once for each item
+func SortOn(foldable Foldable, keyFunc func(T) T) (Foldable, error) {
+	return SortOnN(foldable, keyFunc, DefaultMaxSortItems)
+}
+
+// SortOnN is SortOn with a limit, like SortByN
+func SortOnN(foldable Foldable, keyFunc func(T) T, maxItems int) (Foldable, error) {
+	return sortItems(foldable, maxItems, func(items []T) {
+		keys := make([]T, len(items))
+		for i, item := range items {
+			keys[i] = keyFunc(item)
+		}
+		sort.Stable(keyedItems{keys: keys, items: items})
+	})
+}
+
+// keyedItems sorts items by the natural order of their keys, keeping both in step
+type keyedItems struct {
+	keys, items []T
+}
+
+func (keyed keyedItems) Len() int {
+	return len(keyed.items)
+}
+
+func (keyed keyedItems) Less(i, j int) bool {
+	return NaturalLess(keyed.keys[i], keyed.keys[j])
+}
+
+func (keyed keyedItems) Swap(i, j int) {
+	keyed.keys[i], keyed.keys[j] = keyed.keys[j], keyed.keys[i]
+	keyed.items[i], keyed.items[j] = keyed.items[j], keyed.items[i]
+}
+
+// ThenBy uses each Less in turn, until one of them says the items are not equal
+func ThenBy(less Less, others ...Less) Less {
+	all := append([]Less{less}, others...)
+	return func(a, b T) bool {
+		for _, less := range all {
+			if less(a, b) {
+				return true
+			}
+			if less(b, a) {
+				return false
+			}
+		}
+		return false
+	}
+}
+
+// Descending reverses the order of less
+func Descending(less Less) Less {
+	return func(a, b T) bool {
+		return less(b, a)
+	}
+}
+
+// OnKey compares the natural order of the key from keyFunc, see NaturalLess
+func OnKey(keyFunc func(T) T) Less {
+	return func(a, b T) bool {
+		return NaturalLess(keyFunc(a), keyFunc(b))
+	}
+}
+
+// NaturalLess is the natural order of ints, float64s and strings
+// both values must be the same type, anything else panics
+func NaturalLess(a, b T) bool {
+	switch a := a.(type) {
+	case int:
+		return a < b.(int)
+	case float64:
+		return a < b.(float64)
+	case string:
+		return a < b.(string)
+	}
+	panic(fmt.Sprintf("foldable: no natural order for %T", a))
+}
+
+// sortItems collects the items into a slice, sorts them with sortFunc, then puts them back into the same kind of foldable
+func sortItems(foldable Foldable, maxItems int, sortFunc func([]T)) (Foldable, error) {
+	if isAsync(foldable) {
+		tooMany := false
+		items := FoldlWhile(foldable, []T{}, func(result, next T) (T, bool) {
+			if len(result.([]T)) >= maxItems {
+				tooMany = true
+				return result, false
+			}
+			return append(result.([]T), next), true
+		}).([]T)
+		if tooMany {
+			return nil, ErrTooManyToSort
+		}
+		sortFunc(items)
+		return sortInto(foldable, List(items)), nil
+	}
+	items := ToList(foldable)
+	sortFunc(items)
+	return sortInto(foldable, List(items)), nil
+}
+
+// keyOrdered is for foldables which always fold in their own order, like the order of their keys, so can't hold sorted items
+type keyOrdered interface {
+	keyOrdered()
+}
+
+// sortInto puts the sorted items into the same kind of foldable as the original
+func sortInto(original Foldable, sorted List) Foldable {
+	if isAsync(original) {
+		return asyncOf(original, sorted)
+	}
+	if _, ok := original.(keyOrdered); ok {
+		// these have their own order, so the sorted entries are kept in a list
+		return sorted
+	}
+	return MapToType(original, sorted, func(next T) T { return next })
+}
@@ -0,0 +1,92 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func intLess(a, b T) bool {
+	return a.(int) < b.(int)
+}
+
+func TestListSortBy(t *testing.T) {
+	expected := List{1, 2, 3, 4}
+	got, err := SortBy(List{3, 1, 4, 2}, intLess)
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestIntFoldableSortByDescending(t *testing.T) {
+	expected := IntFoldable{4, 3, 2, 1}
+	got, err := SortBy(IntFoldable{3, 1, 4, 2}, Descending(intLess))
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestHashSortByValue(t *testing.T) {
+	expected := List{HashEntry{"b", 1}, HashEntry{"c", 2}, HashEntry{"a", 3}}
+	got, err := SortBy(Hash{"a": 3, "b": 1, "c": 2}, func(a, b T) bool {
+		return a.(HashEntry).Value.(int) < b.(HashEntry).Value.(int)
+	})
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestListSortStableByThenBy(t *testing.T) {
+	in := List{Pair{"b", 1}, Pair{"a", 2}, Pair{"b", 0}, Pair{"a", 1}, Pair{"a", 2}}
+	byLeft := OnKey(func(x T) T { return x.(Pair).Left })
+	byRight := OnKey(func(x T) T { return x.(Pair).Right })
+	expected := List{Pair{"a", 2}, Pair{"a", 2}, Pair{"a", 1}, Pair{"b", 1}, Pair{"b", 0}}
+	got, err := SortStableBy(in, ThenBy(byLeft, Descending(byRight)))
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+}
+
+func TestListSortOn(t *testing.T) {
+	// stable, so "bb" stays before "aa"
+	expected := List{"c", "bb", "aa", "ddd"}
+	calls := 0
+	got, err := SortOn(List{"bb", "ddd", "aa", "c"}, func(x T) T {
+		calls++
+		return len(x.(string))
+	})
+	if err != nil || !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v, %v expected %v", got, err, expected)
+	}
+	if calls != 4 {
+		t.Errorf("result == %v expected %v", calls, 4)
+	}
+}
+
+func TestChannelSortBy(t *testing.T) {
+	expected := []T{1, 2, 3}
+	got, err := SortBy(ToChannel(List{3, 1, 2}), intLess)
+	if err != nil || !reflect.DeepEqual(expected, ToList(got)) {
+		t.Errorf("result == %v expected %v", err, expected)
+	}
+}
+
+func TestChannelSortUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	got, err := SortByN(naturals(done), intLess, 100)
+	if err != ErrTooManyToSort || got != nil {
+		t.Errorf("result == %v, %v expected %v", got, err, ErrTooManyToSort)
+	}
+}
+
+func TestChannelSortLimit(t *testing.T) {
+	// exactly at the limit is fine, one more is too many
+	got, err := SortOnN(ToChannel(List{2, 1}), func(x T) T { return x }, 2)
+	if err != nil || !reflect.DeepEqual([]T{1, 2}, ToList(got)) {
+		t.Errorf("result == %v, %v expected %v", got, err, []T{1, 2})
+	}
+	got, err = SortStableByN(ToChannel(List{3, 2, 1}), intLess, 2)
+	if err != ErrTooManyToSort || got != nil {
+		t.Errorf("result == %v, %v expected %v", got, err, ErrTooManyToSort)
+	}
+}
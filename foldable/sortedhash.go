@@ -0,0 +1,354 @@
+package foldable
+
+// SortedHash is a Hash kept in key order in a left leaning red black tree
+// Hash sorts all the keys on every fold, this keeps them sorted as they are added
+// so Append, Get and Delete are O(log n), and Floor, Ceiling and folds over a range of keys don't need to look at every entry
+// like Hash, Append and Delete change it for everything which shares it
+// the zero value is an empty SortedHash
+type SortedHash struct {
+	tree *redBlackTree
+}
+
+type redBlackTree struct {
+	root  *treeNode
+	count int
+}
+
+// the colour is of the link from the parent, a red link joins two nodes into one 3-node of a 2-3 tree
+// red links always lean left, which halves the number of cases to balance
+type treeNode struct {
+	entry       HashEntry
+	left, right *treeNode
+	red         bool
+}
+
+// NewSortedHash returns an empty SortedHash
+func NewSortedHash() SortedHash {
+	return SortedHash{tree: &redBlackTree{}}
+}
+
+// SortedHashOf returns a SortedHash with the same entries as a Hash
+func SortedHashOf(hash Hash) SortedHash {
+	result := NewSortedHash()
+	for key, value := range hash {
+		result = result.Put(key, value)
+	}
+	return result
+}
+
+// Len returns the number of entries
+func (foldable SortedHash) Len() int {
+	if foldable.tree == nil {
+		return 0
+	}
+	return foldable.tree.count
+}
+
+func (foldable SortedHash) root() *treeNode {
+	if foldable.tree == nil {
+		return nil
+	}
+	return foldable.tree.root
+}
+
+// Get returns the value for key, and false if there isn't one
+func (foldable SortedHash) Get(key string) (T, bool) {
+	for node := foldable.root(); node != nil; {
+		switch {
+		case key < node.entry.Key:
+			node = node.left
+		case key > node.entry.Key:
+			node = node.right
+		default:
+			return node.entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Floor returns the entry with the largest key which is less than or equal to key, and false if there isn't one
+func (foldable SortedHash) Floor(key string) (HashEntry, bool) {
+	result, found := HashEntry{}, false
+	for node := foldable.root(); node != nil; {
+		switch {
+		case key < node.entry.Key:
+			node = node.left
+		case key > node.entry.Key:
+			result, found = node.entry, true
+			node = node.right
+		default:
+			return node.entry, true
+		}
+	}
+	return result, found
+}
+
+// Ceiling returns the entry with the smallest key which is greater than or equal to key, and false if there isn't one
+func (foldable SortedHash) Ceiling(key string) (HashEntry, bool) {
+	result, found := HashEntry{}, false
+	for node := foldable.root(); node != nil; {
+		switch {
+		case key < node.entry.Key:
+			result, found = node.entry, true
+			node = node.left
+		case key > node.entry.Key:
+			node = node.right
+		default:
+			return node.entry, true
+		}
+	}
+	return result, found
+}
+
+// Put sets key to value
+func (foldable SortedHash) Put(key string, value T) SortedHash {
+	if foldable.tree == nil {
+		foldable = NewSortedHash()
+	}
+	var added bool
+	foldable.tree.root, added = foldable.tree.root.put(HashEntry{Key: key, Value: value})
+	foldable.tree.root.red = false
+	if added {
+		foldable.tree.count++
+	}
+	return foldable
+}
+
+// Delete removes key
+func (foldable SortedHash) Delete(key string) SortedHash {
+	if _, ok := foldable.Get(key); !ok {
+		return foldable
+	}
+	root := foldable.tree.root
+	if !root.left.isRed() && !root.right.isRed() {
+		root.red = true
+	}
+	root = root.delete(key)
+	if root != nil {
+		root.red = false
+	}
+	foldable.tree.root = root
+	foldable.tree.count--
+	return foldable
+}
+
+// FoldlRange is Foldl over only the entries with keys from from up to, but not including, to
+func (foldable SortedHash) FoldlRange(from, to string, init T, foldFunc func(result, next T) T) T {
+	result := init
+	foldable.root().ascend(keyRange{from: from, to: to, bounded: true}, func(entry HashEntry) bool {
+		result = foldFunc(result, entry)
+		return true
+	})
+	return result
+}
+
+// FoldrRange is Foldr over only the entries with keys from from up to, but not including, to
+func (foldable SortedHash) FoldrRange(from, to string, init T, foldFunc func(next, result T) T) T {
+	result := init
+	foldable.root().descend(keyRange{from: from, to: to, bounded: true}, func(entry HashEntry) {
+		result = foldFunc(entry, result)
+	})
+	return result
+}
+
+func (foldable SortedHash) Foldl(init T, foldFunc func(result, next T) T) T {
+	return foldable.FoldlWhile(init, func(result, next T) (T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (foldable SortedHash) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	foldable.root().ascend(keyRange{}, func(entry HashEntry) bool {
+		var more bool
+		result, more = foldFunc(result, entry)
+		return more
+	})
+	return result
+}
+
+func (foldable SortedHash) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	foldable.root().descend(keyRange{}, func(entry HashEntry) {
+		result = foldFunc(entry, result)
+	})
+	return result
+}
+
+func (foldable SortedHash) Init() Foldable {
+	return NewSortedHash()
+}
+
+func (foldable SortedHash) Append(item T) Foldable {
+	return foldable.Put(item.(HashEntry).Key, item.(HashEntry).Value)
+}
+
+func (foldable SortedHash) keyOrdered() {}
+
+// keyRange is from up to, but not including, to
+// without bounded it is every key
+type keyRange struct {
+	from, to string
+	bounded  bool
+}
+
+func (r keyRange) aboveFrom(key string) bool { return !r.bounded || key >= r.from }
+func (r keyRange) belowTo(key string) bool   { return !r.bounded || key < r.to }
+
+// ascend calls f for each entry in the range in key order, until f returns false
+// subtrees which are all outside the range are skipped
+func (node *treeNode) ascend(r keyRange, f func(HashEntry) bool) bool {
+	if node == nil {
+		return true
+	}
+	key := node.entry.Key
+	if r.aboveFrom(key) {
+		if !node.left.ascend(r, f) {
+			return false
+		}
+		if r.belowTo(key) && !f(node.entry) {
+			return false
+		}
+	}
+	if r.belowTo(key) {
+		return node.right.ascend(r, f)
+	}
+	return true
+}
+
+// descend calls f for each entry in the range in reverse key order
+func (node *treeNode) descend(r keyRange, f func(HashEntry)) {
+	if node == nil {
+		return
+	}
+	key := node.entry.Key
+	if r.belowTo(key) {
+		node.right.descend(r, f)
+		if r.aboveFrom(key) {
+			f(node.entry)
+		}
+	}
+	if r.aboveFrom(key) {
+		node.left.descend(r, f)
+	}
+}
+
+func (node *treeNode) isRed() bool {
+	return node != nil && node.red
+}
+
+func (node *treeNode) put(entry HashEntry) (*treeNode, bool) {
+	if node == nil {
+		return &treeNode{entry: entry, red: true}, true
+	}
+	added := false
+	switch {
+	case entry.Key < node.entry.Key:
+		node.left, added = node.left.put(entry)
+	case entry.Key > node.entry.Key:
+		node.right, added = node.right.put(entry)
+	default:
+		node.entry = entry
+	}
+	return node.balance(), added
+}
+
+// delete assumes key is in the tree
+// on the way down it moves a red link along, so the node finally removed is never a 2-node
+func (node *treeNode) delete(key string) *treeNode {
+	if key < node.entry.Key {
+		if !node.left.isRed() && !node.left.left.isRed() {
+			node = node.moveRedLeft()
+		}
+		node.left = node.left.delete(key)
+		return node.balance()
+	}
+	if node.left.isRed() {
+		node = node.rotateRight()
+	}
+	if key == node.entry.Key && node.right == nil {
+		return nil
+	}
+	if !node.right.isRed() && !node.right.left.isRed() {
+		node = node.moveRedRight()
+	}
+	if key == node.entry.Key {
+		smallest := node.right
+		for smallest.left != nil {
+			smallest = smallest.left
+		}
+		node.entry = smallest.entry
+		node.right = node.right.deleteMin()
+	} else {
+		node.right = node.right.delete(key)
+	}
+	return node.balance()
+}
+
+func (node *treeNode) deleteMin() *treeNode {
+	if node.left == nil {
+		return nil
+	}
+	if !node.left.isRed() && !node.left.left.isRed() {
+		node = node.moveRedLeft()
+	}
+	node.left = node.left.deleteMin()
+	return node.balance()
+}
+
+func (node *treeNode) rotateLeft() *treeNode {
+	right := node.right
+	node.right = right.left
+	right.left = node
+	right.red = node.red
+	node.red = true
+	return right
+}
+
+func (node *treeNode) rotateRight() *treeNode {
+	left := node.left
+	node.left = left.right
+	left.right = node
+	left.red = node.red
+	node.red = true
+	return left
+}
+
+func (node *treeNode) flipColours() {
+	node.red = !node.red
+	node.left.red = !node.left.red
+	node.right.red = !node.right.red
+}
+
+func (node *treeNode) moveRedLeft() *treeNode {
+	node.flipColours()
+	if node.right.left.isRed() {
+		node.right = node.right.rotateRight()
+		node = node.rotateLeft()
+		node.flipColours()
+	}
+	return node
+}
+
+func (node *treeNode) moveRedRight() *treeNode {
+	node.flipColours()
+	if node.left.left.isRed() {
+		node = node.rotateRight()
+		node.flipColours()
+	}
+	return node
+}
+
+// balance fixes any right leaning red link, or two red links in a row, on the way back up
+func (node *treeNode) balance() *treeNode {
+	if node.right.isRed() && !node.left.isRed() {
+		node = node.rotateLeft()
+	}
+	if node.left.isRed() && node.left.left.isRed() {
+		node = node.rotateRight()
+	}
+	if node.left.isRed() && node.right.isRed() {
+		node.flipColours()
+	}
+	return node
+}
@@ -0,0 +1,106 @@
+package foldable
+
+import (
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func days() SortedHash {
+	return SortedHashOf(Hash{"2024-01-03": 3, "2024-01-01": 1, "2024-01-05": 5, "2024-01-04": 4})
+}
+
+// blackHeight returns the number of black links to every leaf, or -1 if the tree is not balanced
+func blackHeight(node *treeNode) int {
+	if node == nil {
+		return 0
+	}
+	if node.right.isRed() || (node.red && node.left.isRed()) {
+		return -1
+	}
+	left, right := blackHeight(node.left), blackHeight(node.right)
+	if left < 0 || left != right {
+		return -1
+	}
+	if node.red {
+		return left
+	}
+	return left + 1
+}
+
+func TestSortedHashRandom(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	got, expected := SortedHash{}, Hash{}
+	for i := 0; i < 5000; i++ {
+		key := strconv.Itoa(random.Intn(500))
+		if random.Intn(3) == 0 {
+			got = got.Delete(key)
+			delete(expected, key)
+		} else {
+			got = got.Put(key, i)
+			expected[key] = i
+		}
+		if blackHeight(got.root()) < 0 {
+			t.Fatalf("tree is not balanced after %v changes", i+1)
+		}
+	}
+	if got.Len() != len(expected) {
+		t.Errorf("result == %v expected %v", got.Len(), len(expected))
+	}
+	if !reflect.DeepEqual(ToList(expected), ToList(got)) {
+		t.Errorf("result == %v expected %v", ToList(got), ToList(expected))
+	}
+}
+
+func TestSortedHashFloorCeiling(t *testing.T) {
+	in := days()
+	tests := []struct {
+		key                  string
+		floor, ceiling       T
+		hasFloor, hasCeiling bool
+	}{
+		{"2024-01-02", 1, 3, true, true},
+		{"2024-01-03", 3, 3, true, true},
+		{"2023-12-31", nil, 1, false, true},
+		{"2024-01-06", 5, nil, true, false},
+	}
+	for _, test := range tests {
+		floor, ok := in.Floor(test.key)
+		if ok != test.hasFloor || (ok && floor.Value != test.floor) {
+			t.Errorf("floor of %v result == %v expected %v", test.key, floor, test.floor)
+		}
+		ceiling, ok := in.Ceiling(test.key)
+		if ok != test.hasCeiling || (ok && ceiling.Value != test.ceiling) {
+			t.Errorf("ceiling of %v result == %v expected %v", test.key, ceiling, test.ceiling)
+		}
+	}
+}
+
+func TestSortedHashRanges(t *testing.T) {
+	in := days()
+	collect := func(result, next T) T { return append(result.([]T), next.(HashEntry).Value) }
+	got := in.FoldlRange("2024-01-02", "2024-01-05", []T{}, collect)
+	expected := []T{3, 4}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	got = in.FoldrRange("2024-01-01", "2024-01-05", []T{}, func(next, result T) T { return collect(result, next) })
+	expected = []T{4, 3, 1}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	got = Foldr(in, []T{}, func(next, result T) T { return collect(result, next) })
+	expected = []T{5, 4, 3, 1}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestSortedHashTakeStopsEarly(t *testing.T) {
+	got := ToList(Take(days(), 2))
+	expected := []T{HashEntry{"2024-01-01", 1}, HashEntry{"2024-01-03", 3}}
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
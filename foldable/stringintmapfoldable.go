@@ -32,6 +32,17 @@ func (foldable StringIntMapFoldable) Foldl(init T, foldFunc func(result, next T)
 	return result
 }
 
+func (foldable StringIntMapFoldable) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for _, key := range foldable.sortedKeys() {
+		var more bool
+		if result, more = foldFunc(result, StringIntEntryItem{Key: key, Value: foldable.Map[key]}); !more {
+			break
+		}
+	}
+	return result
+}
+
 func (foldable StringIntMapFoldable) Init() Foldable {
 	return StringIntMapFoldable{Map: make(map[string]int)}
 }
@@ -40,3 +51,5 @@ func (foldable StringIntMapFoldable) Append(item T) Foldable {
 	foldable.Map[item.(StringIntEntryItem).Key] = item.(StringIntEntryItem).Value
 	return StringIntMapFoldable{Map: foldable.Map}
 }
+
+func (foldable StringIntMapFoldable) keyOrdered() {}
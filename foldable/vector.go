@@ -0,0 +1,197 @@
+package foldable
+
+import (
+	"fmt"
+)
+
+// Vector is a persistent vector, like the one in clojure
+// appending to a List can write into a backing array shared with another List, so two Lists made from the same one can overwrite each other
+// a Vector is never changed, every Append or Set returns a new Vector which shares everything it can with the old one
+// the items are kept in a tree of nodes with 32 children each, indexed by 5 bits of the index at each level
+// so Get, Set and Append are O(log32 n), which is at most 7 levels for any int index
+// the last partial node is kept separately as the tail, which makes most appends a copy of at most 32 items
+// the zero value is an empty Vector
+type Vector struct {
+	count int
+	// how many bits of the index are used below the root
+	shift uint
+	root  *vectorNode
+	tail  []T
+}
+
+// vectorNode is either a branch, with children, or a leaf with 32 items
+type vectorNode struct {
+	children [32]*vectorNode
+	items    []T
+}
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// VectorOf returns a Vector of the given items
+func VectorOf(items ...T) Vector {
+	vector := Vector{}
+	for _, item := range items {
+		vector = vector.Push(item)
+	}
+	return vector
+}
+
+// Len returns the number of items
+func (vector Vector) Len() int {
+	return vector.count
+}
+
+// Get returns the item at index i, it panics if i is out of range like a slice would
+func (vector Vector) Get(i int) T {
+	return vector.leafFor(i)[i&vectorMask]
+}
+
+// Set returns a new Vector with the item at index i replaced
+func (vector Vector) Set(i int, item T) Vector {
+	vector.checkIndex(i)
+	if i >= vector.tailOffset() {
+		tail := append([]T{}, vector.tail...)
+		tail[i&vectorMask] = item
+		vector.tail = tail
+		return vector
+	}
+	vector.root = vector.set(vector.shift, vector.root, i, item)
+	return vector
+}
+
+func (vector Vector) set(level uint, node *vectorNode, i int, item T) *vectorNode {
+	copied := *node
+	if level == 0 {
+		copied.items = append([]T{}, node.items...)
+		copied.items[i&vectorMask] = item
+		return &copied
+	}
+	child := (i >> level) & vectorMask
+	copied.children[child] = vector.set(level-vectorBits, node.children[child], i, item)
+	return &copied
+}
+
+// Push returns a new Vector with the item on the end
+func (vector Vector) Push(item T) Vector {
+	if vector.root == nil {
+		vector.root = &vectorNode{}
+		vector.shift = vectorBits
+	}
+	if vector.count-vector.tailOffset() < vectorWidth {
+		// there's room in the tail, the copy means nothing shares the new tail
+		tail := make([]T, len(vector.tail), len(vector.tail)+1)
+		copy(tail, vector.tail)
+		vector.tail = append(tail, item)
+		vector.count++
+		return vector
+	}
+	// the tail is full, so it becomes a leaf of the tree
+	leaf := &vectorNode{items: vector.tail}
+	if (vector.count >> vectorBits) > (1 << vector.shift) {
+		// the tree is full, so it gets another level
+		root := &vectorNode{}
+		root.children[0] = vector.root
+		root.children[1] = newVectorPath(vector.shift, leaf)
+		vector.root = root
+		vector.shift += vectorBits
+	} else {
+		vector.root = vector.pushLeaf(vector.shift, vector.root, leaf)
+	}
+	vector.tail = []T{item}
+	vector.count++
+	return vector
+}
+
+func (vector Vector) pushLeaf(level uint, parent *vectorNode, leaf *vectorNode) *vectorNode {
+	copied := *parent
+	child := ((vector.count - 1) >> level) & vectorMask
+	if level == vectorBits {
+		copied.children[child] = leaf
+	} else if parent.children[child] != nil {
+		copied.children[child] = vector.pushLeaf(level-vectorBits, parent.children[child], leaf)
+	} else {
+		copied.children[child] = newVectorPath(level-vectorBits, leaf)
+	}
+	return &copied
+}
+
+// newVectorPath returns the branches needed to put a leaf level bits below
+func newVectorPath(level uint, leaf *vectorNode) *vectorNode {
+	if level == 0 {
+		return leaf
+	}
+	node := &vectorNode{}
+	node.children[0] = newVectorPath(level-vectorBits, leaf)
+	return node
+}
+
+// tailOffset is the index of the first item in the tail
+func (vector Vector) tailOffset() int {
+	if vector.count < vectorWidth {
+		return 0
+	}
+	return ((vector.count - 1) >> vectorBits) << vectorBits
+}
+
+func (vector Vector) checkIndex(i int) {
+	if i < 0 || i >= vector.count {
+		panic(fmt.Sprintf("foldable: index %d out of range for Vector of length %d", i, vector.count))
+	}
+}
+
+// leafFor returns the items of the leaf, or tail, which has index i
+func (vector Vector) leafFor(i int) []T {
+	vector.checkIndex(i)
+	if i >= vector.tailOffset() {
+		return vector.tail
+	}
+	node := vector.root
+	for level := vector.shift; level > 0; level -= vectorBits {
+		node = node.children[(i>>level)&vectorMask]
+	}
+	return node.items
+}
+
+func (vector Vector) Foldl(init T, foldFunc func(result, next T) T) T {
+	result := init
+	// a whole leaf at a time, rather than walking the tree for every item
+	for i := 0; i < vector.count; i += vectorWidth {
+		for _, x := range vector.leafFor(i) {
+			result = foldFunc(result, x)
+		}
+	}
+	return result
+}
+
+func (vector Vector) FoldlWhile(init T, foldFunc func(result, next T) (T, bool)) T {
+	result := init
+	for i := 0; i < vector.count; i += vectorWidth {
+		for _, x := range vector.leafFor(i) {
+			var more bool
+			if result, more = foldFunc(result, x); !more {
+				return result
+			}
+		}
+	}
+	return result
+}
+
+func (vector Vector) Foldr(init T, foldFunc func(next, result T) T) T {
+	result := init
+	for i := vector.count - 1; i >= 0; i-- {
+		result = foldFunc(vector.Get(i), result)
+	}
+	return result
+}
+
+func (vector Vector) Init() Foldable {
+	return Vector{}
+}
+
+func (vector Vector) Append(item T) Foldable {
+	return vector.Push(item)
+}
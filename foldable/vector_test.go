@@ -0,0 +1,100 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVectorAppend(t *testing.T) {
+	expected := []T{1, 2, 3}
+	got := ToList(Vector{}.Append(1).Append(2).Append(3))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestVectorBranching(t *testing.T) {
+	// with a List with spare capacity, both appends would write to the same place in a shared backing array
+	vector := VectorOf(0)
+	va, vb := vector.Push(1), vector.Push(2)
+	if got := ToList(va); !reflect.DeepEqual([]T{0, 1}, got) {
+		t.Errorf("result == %v expected %v", got, []T{0, 1})
+	}
+	if got := ToList(vb); !reflect.DeepEqual([]T{0, 2}, got) {
+		t.Errorf("result == %v expected %v", got, []T{0, 2})
+	}
+}
+
+func TestVectorLarge(t *testing.T) {
+	// enough items for three levels of the tree
+	n := vectorWidth*vectorWidth*vectorWidth + 5
+	vector := Vector{}
+	for i := 0; i < n; i++ {
+		vector = vector.Push(i)
+	}
+	if vector.Len() != n {
+		t.Fatalf("result == %v expected %v", vector.Len(), n)
+	}
+	for i := 0; i < n; i++ {
+		if got := vector.Get(i); got != i {
+			t.Fatalf("result == %v expected %v", got, i)
+		}
+	}
+	if got := Length(vector); got != n {
+		t.Errorf("result == %v expected %v", got, n)
+	}
+	last, _ := Last(vector)
+	if last != n-1 {
+		t.Errorf("result == %v expected %v", last, n-1)
+	}
+}
+
+func TestVectorSet(t *testing.T) {
+	n := vectorWidth*vectorWidth + 3
+	original := Vector{}
+	for i := 0; i < n; i++ {
+		original = original.Push(i)
+	}
+	// one in the tree, and one in the tail
+	changed := original.Set(40, -1).Set(n-1, -2)
+	if changed.Get(40) != -1 || changed.Get(n-1) != -2 {
+		t.Errorf("result == %v, %v expected %v, %v", changed.Get(40), changed.Get(n-1), -1, -2)
+	}
+	if original.Get(40) != 40 || original.Get(n-1) != n-1 {
+		t.Errorf("result == %v, %v expected the original to be unchanged", original.Get(40), original.Get(n-1))
+	}
+	// everything else is still shared
+	if changed.Get(39) != 39 || changed.root.children[5] != original.root.children[5] {
+		t.Errorf("result == %v expected the rest to be shared", changed.Get(39))
+	}
+}
+
+func TestVectorGetOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected a panic")
+		}
+	}()
+	VectorOf(1).Get(1)
+}
+
+func TestVectorMapFilter(t *testing.T) {
+	expected := VectorOf(4, 8)
+	got := Filter(Map(VectorOf(1, 2, 3, 4), func(x T) T { return x.(int) * 2 }), func(x T) bool {
+		return x.(int)%4 == 0
+	})
+	if !reflect.DeepEqual(ToList(expected), ToList(got)) {
+		t.Errorf("result == %v expected %v", ToList(got), ToList(expected))
+	}
+	if _, ok := got.(Vector); !ok {
+		t.Errorf("result == %T expected a Vector", got)
+	}
+}
+
+func TestVectorTakeReverse(t *testing.T) {
+	expected := []T{3, 2, 1}
+	got := ToList(Reverse(Take(VectorOf(1, 2, 3, 4), 3)))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,127 @@
+package foldable
+
+// zipping needs the next item from each input in turn, rather than folding over one of them
+// so each input is turned into a pull function
+// a List or IntFoldable is read by index, a Channel, Async or Seq is read one item at a time,
+// and anything else has to be converted to a list first
+
+// Triple a tuple of three somethings
+type Triple struct {
+	Left   T
+	Middle T
+	Right  T
+}
+
+// ZipWith combines corresponding items with zipFunc, only up to the shortest of a and b
+// if either input is a Channel, or any other Async, the result is the same kind which is sent each item as soon as both inputs have one
+// otherwise the result is a List
+func ZipWith(a, b Foldable, zipFunc func(x, y T) T) Foldable {
+	return combineInto(zipTarget(a, b), func(result Foldable) Foldable {
+		nextA, nextB := pull(a), pull(b)
+		for {
+			x, ok := nextA()
+			if !ok {
+				return result
+			}
+			y, ok := nextB()
+			if !ok {
+				return result
+			}
+			result = result.Append(zipFunc(x, y))
+		}
+	})
+}
+
+// Zip3 combines corresponding items into a Triple, only up to the shortest of a, b and c
+// the result is the same kind as ZipWith
+func Zip3(a, b, c Foldable) Foldable {
+	return combineInto(zipTarget(a, b, c), func(result Foldable) Foldable {
+		nextA, nextB, nextC := pull(a), pull(b), pull(c)
+		for {
+			x, ok := nextA()
+			if !ok {
+				return result
+			}
+			y, ok := nextB()
+			if !ok {
+				return result
+			}
+			z, ok := nextC()
+			if !ok {
+				return result
+			}
+			result = result.Append(Triple{x, y, z})
+		}
+	})
+}
+
+// ZipLongest combines corresponding pairs of values, up to the longest of a and b
+// once one of them runs out, its fill value is used in its place
+// the result is the same kind as ZipWith
+func ZipLongest(a, b Foldable, fillA, fillB T) Foldable {
+	return combineInto(zipTarget(a, b), func(result Foldable) Foldable {
+		nextA, nextB := pull(a), pull(b)
+		for {
+			x, okA := nextA()
+			y, okB := nextB()
+			if !okA && !okB {
+				return result
+			}
+			if !okA {
+				x = fillA
+				// don't pull from it again
+				nextA = func() (T, bool) { return nil, false }
+			}
+			if !okB {
+				y = fillB
+				nextB = func() (T, bool) { return nil, false }
+			}
+			result = result.Append(Pair{x, y})
+		}
+	})
+}
+
+// zipTarget is the same kind as the first Async input, otherwise a List
+func zipTarget(foldables ...Foldable) Foldable {
+	for _, foldable := range foldables {
+		if isAsync(foldable) {
+			return foldable.Init()
+		}
+	}
+	return List{}
+}
+
+// pull returns a function which returns the next item each time it's called, and false once there are none left
+func pull(foldable Foldable) func() (T, bool) {
+	switch foldable := foldable.(type) {
+	case Seq:
+		return foldable()
+	case Channel:
+		return func() (T, bool) {
+			item, ok := <-foldable
+			return item, ok
+		}
+	case List:
+		i := 0
+		return func() (T, bool) {
+			if i >= len(foldable) {
+				return nil, false
+			}
+			i++
+			return foldable[i-1], true
+		}
+	case IntFoldable:
+		i := 0
+		return func() (T, bool) {
+			if i >= len(foldable) {
+				return nil, false
+			}
+			i++
+			return foldable[i-1], true
+		}
+	}
+	if isAsync(foldable) {
+		return pull(ToChannel(foldable))
+	}
+	return pull(List(ToList(foldable)))
+}
@@ -0,0 +1,66 @@
+package foldable
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestListZipWith(t *testing.T) {
+	expected := List{5, 7, 9}
+	got := ZipWith(List{1, 2, 3}, IntFoldable{4, 5, 6, 7}, func(x, y T) T { return x.(int) + y.(int) })
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListZip3(t *testing.T) {
+	expected := List{Triple{1, "a", true}, Triple{2, "b", false}}
+	got := Zip3(List{1, 2, 3}, List{"a", "b"}, BoolFoldable{List: []bool{true, false, true}})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListZipLongest(t *testing.T) {
+	expected := List{Pair{1, "a"}, Pair{2, "-"}, Pair{3, "-"}}
+	got := ZipLongest(List{1, 2, 3}, List{"a"}, 0, "-")
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	expected = List{Pair{"a", 1}, Pair{"-", 2}, Pair{"-", 3}}
+	got = ZipLongest(List{"a"}, List{1, 2, 3}, "-", 0)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashZip(t *testing.T) {
+	// anything else is zipped in fold order
+	expected := List{Pair{HashEntry{"a", 1}, 10}, Pair{HashEntry{"b", 2}, 20}}
+	got := Zip(Hash{"b": 2, "a": 1}, List{10, 20})
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelZipUnbounded(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	expected := []T{Pair{0, "a"}, Pair{1, "b"}}
+	got := ToList(Zip(naturals(done), List{"a", "b"}))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelZipWithLockstep(t *testing.T) {
+	done := make(chan struct{})
+	defer close(done)
+	// both inputs are unbounded, so neither can be read in full
+	expected := []T{0, 2, 4}
+	zipped := ZipWith(naturals(done), naturals(done), func(x, y T) T { return x.(int) + y.(int) })
+	got := ToList(Take(zipped, 3))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
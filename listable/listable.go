@@ -99,22 +99,44 @@ func Concat(a, b []T) []T {
 // 	return result.Foldable
 // }
 
-func async(waitGroup *sync.WaitGroup, mapFunc func() T) *T {
+// async runs mapFunc in a go func, and returns where the result will be once waitGroup is done
+// if limit is not nil, it is used as a semaphore, so this blocks until there is room for another go func
+func async(waitGroup *sync.WaitGroup, limit chan struct{}, mapFunc func() T) *T {
 	var r T
 	waitGroup.Add(1)
+	if limit != nil {
+		limit <- struct{}{}
+	}
 	go func() {
 		r = mapFunc()
+		if limit != nil {
+			<-limit
+		}
 		waitGroup.Done()
 	}()
 	return &r
 }
 
 // ParMap applies a function in parallel to each item inside the foldable
+// every item gets its own go func, see ParMapN to limit that
 func ParMap(list []T, mapFunc func(T) T) []T {
+	return parMap(list, nil, mapFunc)
+}
+
+// ParMapN is ParMap, but with at most workers items being processed at once
+// the result is still in the same order as the input
+func ParMapN(list []T, workers int, mapFunc func(T) T) []T {
+	if workers < 1 {
+		workers = 1
+	}
+	return parMap(list, make(chan struct{}, workers), mapFunc)
+}
+
+func parMap(list []T, limit chan struct{}, mapFunc func(T) T) []T {
 	waitGroup := &sync.WaitGroup{}
 	init := []*T{}
 	pendingResults := Foldl(list, init, func(result, next T) T {
-		asyncResult := async(waitGroup, func() T { return mapFunc(next) })
+		asyncResult := async(waitGroup, limit, func() T { return mapFunc(next) })
 		return append(result.([]*T), asyncResult)
 	}).([]*T)
 	waitGroup.Wait()
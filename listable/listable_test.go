@@ -3,6 +3,7 @@ package listable
 import (
 	"fmt"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -109,3 +110,28 @@ func TestIntFoldableParMap(t *testing.T) {
 		t.Errorf("result == %d expected %d", got, expected)
 	}
 }
+
+func TestIntFoldableParMapN(t *testing.T) {
+	var running, max int32
+	mapFunc := func(x T) T {
+		now := atomic.AddInt32(&running, 1)
+		for {
+			previous := atomic.LoadInt32(&max)
+			if now <= previous || atomic.CompareAndSwapInt32(&max, previous, now) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return x.(int) * 2
+	}
+	in := []T{1, 2, 3, 4, 5, 6}
+	expected := []T{2, 4, 6, 8, 10, 12}
+	got := ParMapN(in, 2, mapFunc)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	if max > 2 {
+		t.Errorf("result == %v expected at most %v", max, 2)
+	}
+}
@@ -0,0 +1,250 @@
+package typed
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/caspersg/gofuncs/foldable"
+)
+
+// the adapters move values between this package and the untyped foldable package
+// going from untyped to typed needs a cast for each item, which is checked when the item is reached
+// going from typed to untyped can never fail, but the untyped Append can still be given the wrong type
+
+// as is the one place untyped values are cast
+// nil is the zero value if E can be nil, and anything else of the wrong type panics with both types named
+// a foldable.HashEntry is converted when E is a HashEntry, casting the value
+func as[E any](x any) E {
+	var zero E
+	if x == nil {
+		if !nilable[E]() {
+			panic(fmt.Sprintf("typed: expected item of type %v but got nil", reflect.TypeOf((*E)(nil)).Elem()))
+		}
+		return zero
+	}
+	e, ok := x.(E)
+	if ok {
+		return e
+	}
+	if entry, isEntry := x.(foldable.HashEntry); isEntry {
+		if converter, ok := any(zero).(entryConverter); ok {
+			return converter.lift(entry).(E)
+		}
+	}
+	panic(fmt.Sprintf("typed: expected item of type %T but got %T", zero, x))
+}
+
+// nilable reports whether nil is a valid E, like an interface, pointer, map, slice, chan or func
+func nilable[E any]() bool {
+	switch reflect.TypeOf((*E)(nil)).Elem().Kind() {
+	case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+		return true
+	}
+	return false
+}
+
+// untyped is the reverse of as, a HashEntry becomes a foldable.HashEntry and anything else is unchanged
+func untyped(x any) foldable.T {
+	if entry, ok := x.(entryConverter); ok {
+		return entry.lower()
+	}
+	return x
+}
+
+// entryConverter is implemented by every HashEntry[V], so entries can be converted without knowing V
+type entryConverter interface {
+	lift(entry foldable.HashEntry) any
+	liftHash(hash foldable.Hash) any
+	lower() foldable.HashEntry
+}
+
+func (HashEntry[V]) lift(entry foldable.HashEntry) any {
+	return HashEntry[V]{Key: entry.Key, Value: as[V](entry.Value)}
+}
+
+func (HashEntry[V]) liftHash(hash foldable.Hash) any {
+	return FromHash[V](hash)
+}
+
+func (entry HashEntry[V]) lower() foldable.HashEntry {
+	return foldable.HashEntry{Key: entry.Key, Value: entry.Value}
+}
+
+// FromList converts an untyped foldable.List into a List of E
+func FromList[E any](list foldable.List) List[E] {
+	result := make(List[E], 0, len(list))
+	for _, x := range list {
+		result = append(result, as[E](x))
+	}
+	return result
+}
+
+// Untyped converts back into a foldable.List
+func (list List[E]) Untyped() foldable.List {
+	result := make(foldable.List, 0, len(list))
+	for _, x := range list {
+		result = append(result, untyped(x))
+	}
+	return result
+}
+
+// FromHash converts an untyped foldable.Hash into a Hash with values of V
+func FromHash[V any](hash foldable.Hash) Hash[V] {
+	result := make(Hash[V], len(hash))
+	for k, v := range hash {
+		result[k] = as[V](v)
+	}
+	return result
+}
+
+// Untyped converts back into a foldable.Hash
+func (hash Hash[V]) Untyped() foldable.Hash {
+	result := make(foldable.Hash, len(hash))
+	for k, v := range hash {
+		result[k] = v
+	}
+	return result
+}
+
+// FromChannel converts an untyped foldable.Channel into a Channel of E
+// items are cast as they arrive, in a go func, so this doesn't block
+func FromChannel[E any](channel foldable.Channel) Channel[E] {
+	result := make(Channel[E])
+	go func() {
+		for x := range channel {
+			result <- as[E](x)
+		}
+		close(result)
+	}()
+	return result
+}
+
+// Untyped converts back into a foldable.Channel, also without blocking
+func (channel Channel[E]) Untyped() foldable.Channel {
+	result := make(foldable.Channel)
+	go func() {
+		for x := range channel {
+			result <- untyped(x)
+		}
+		close(result)
+	}()
+	return result
+}
+
+// Lift allows any untyped foldable.Foldable to be used with the functions in this package
+// the known types are converted, anything else is wrapped so the items are cast as they are folded
+// a foldable.Hash becomes a Hash when E is a HashEntry
+func Lift[E any](f foldable.Foldable) Foldable[E] {
+	switch f := f.(type) {
+	case foldable.List:
+		return FromList[E](f)
+	case foldable.Channel:
+		return FromChannel[E](f)
+	case foldable.Hash:
+		var zero E
+		if entry, ok := any(zero).(entryConverter); ok {
+			return entry.liftHash(f).(Foldable[E])
+		}
+	}
+	return lift[E](f)
+}
+
+// Lower allows any Foldable to be used with the functions in the untyped foldable package
+func Lower[E any](f Foldable[E]) foldable.Foldable {
+	switch f := f.(type) {
+	case List[E]:
+		return f.Untyped()
+	case Channel[E]:
+		return f.Untyped()
+	case lifted[E]:
+		return f.foldable
+	case liftedAsync[E]:
+		return f.foldable
+	case interface{ Untyped() foldable.Hash }:
+		// a Hash has a different type for every value type, so it is found by its method
+		return f.Untyped()
+	}
+	return lower(f)
+}
+
+// lift wraps an untyped foldable, and keeps it Async if it was
+func lift[E any](f foldable.Foldable) Foldable[E] {
+	if _, ok := f.(foldable.Async); ok {
+		return liftedAsync[E]{lifted[E]{foldable: f}}
+	}
+	return lifted[E]{foldable: f}
+}
+
+// lower wraps a typed foldable, and keeps it Async if it was
+func lower[E any](f Foldable[E]) foldable.Foldable {
+	if _, ok := f.(Async); ok {
+		return loweredAsync[E]{lowered[E]{foldable: f}}
+	}
+	return lowered[E]{foldable: f}
+}
+
+// lifted wraps an untyped foldable, casting each item to E
+type lifted[E any] struct {
+	foldable foldable.Foldable
+}
+
+// the untyped foldable can't tell a typed result is Async, so that is checked here
+func (l lifted[E]) Foldl(init any, foldFunc func(result any, next E) any) any {
+	return foldlAsync(init, func(each func(E)) {
+		l.foldable.Foldl(nil, func(result, next foldable.T) foldable.T {
+			each(as[E](next))
+			return result
+		})
+	}, foldFunc)
+}
+
+func (l lifted[E]) Init() Foldable[E] {
+	return lift[E](l.foldable.Init())
+}
+
+func (l lifted[E]) Append(item E) Foldable[E] {
+	return lift[E](l.foldable.Append(untyped(item)))
+}
+
+// liftedAsync is lifted for a foldable.Async, so typed folds into it happen in a go func
+type liftedAsync[E any] struct {
+	lifted[E]
+}
+
+func (l liftedAsync[E]) Close() {
+	l.foldable.(foldable.Async).Close()
+}
+
+// lowered wraps a typed foldable, the reverse of lifted
+type lowered[E any] struct {
+	foldable Foldable[E]
+}
+
+// the typed foldable can't tell an untyped result is Async, so that is checked here
+func (l lowered[E]) Foldl(init foldable.T, foldFunc func(result, next foldable.T) foldable.T) foldable.T {
+	return foldable.FoldlAsync(init, func(each func(foldable.T) bool) {
+		l.foldable.Foldl(nil, func(result any, next E) any {
+			each(untyped(next))
+			return result
+		})
+	}, func(result, next foldable.T) (foldable.T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (l lowered[E]) Init() foldable.Foldable {
+	return lower(l.foldable.Init())
+}
+
+func (l lowered[E]) Append(item foldable.T) foldable.Foldable {
+	return lower(l.foldable.Append(as[E](item)))
+}
+
+// loweredAsync is lowered for a typed Async, so untyped folds into it happen in a go func
+type loweredAsync[E any] struct {
+	lowered[E]
+}
+
+func (l loweredAsync[E]) Close() {
+	l.foldable.(Async).Close()
+}
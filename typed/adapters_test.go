@@ -0,0 +1,170 @@
+package typed
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/caspersg/gofuncs/foldable"
+)
+
+func TestListRoundTrip(t *testing.T) {
+	in := foldable.List{1, 2, 3}
+	got := Map(FromList[int](in), func(x int) int { return x * 2 }).(List[int]).Untyped()
+	expected := foldable.List{2, 4, 6}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashRoundTrip(t *testing.T) {
+	in := foldable.Hash{"a": 1, "b": 2}
+	got := FromHash[int](in).Untyped()
+	if !reflect.DeepEqual(got, in) {
+		t.Errorf("result == %v expected %v", got, in)
+	}
+}
+
+func TestChannelRoundTrip(t *testing.T) {
+	in := foldable.ToChannel(foldable.List{1, 2, 3})
+	got := foldable.ToList(Map(FromChannel[int](in), func(x int) int { return x * 2 }).(Channel[int]).Untyped())
+	expected := []foldable.T{2, 4, 6}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestLiftAndLower(t *testing.T) {
+	lifted := Lift[int](foldable.IntFoldable{1, 2, 3})
+	got := Lower(Filter(lifted, func(x int) bool { return x > 1 })).(foldable.IntFoldable)
+	expected := foldable.IntFoldable{2, 3}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	// and the other way
+	lowered := foldable.Map(Lower[int](List[int]{1, 2}), func(x foldable.T) foldable.T { return x.(int) + 1 })
+	if !reflect.DeepEqual(lowered, foldable.List{2, 3}) {
+		t.Errorf("result == %v expected %v", lowered, foldable.List{2, 3})
+	}
+}
+
+func TestLiftWrongType(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(r.(string), "expected item of type int but got string") {
+			t.Errorf("result == %v expected a typed panic", r)
+		}
+	}()
+	Length(Lift[int](foldable.List{"a"}))
+}
+
+func TestLiftNil(t *testing.T) {
+	// nil can't be an int, so it fails like any other wrong type
+	defer func() {
+		r := recover()
+		if r == nil || !strings.Contains(r.(string), "expected item of type int but got nil") {
+			t.Errorf("result == %v expected a typed panic", r)
+		}
+	}()
+	// but it can be a pointer
+	if got := FromList[*int](foldable.List{nil}); got[0] != nil {
+		t.Errorf("result == %v expected %v", got[0], nil)
+	}
+	Length(Lift[int](foldable.List{nil}))
+}
+
+func TestLiftHash(t *testing.T) {
+	lifted := Lift[HashEntry[int]](foldable.Hash{"a": 1, "b": 2})
+	got := Lower(Filter(lifted, func(x HashEntry[int]) bool { return x.Value > 1 }))
+	expected := foldable.Hash{"b": 2}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	// entries from any other untyped foldable are converted too
+	entries := FromList[HashEntry[int]](foldable.List{foldable.HashEntry{Key: "a", Value: 1}})
+	if !reflect.DeepEqual(entries, List[HashEntry[int]]{{Key: "a", Value: 1}}) {
+		t.Errorf("result == %v expected %v", entries, List[HashEntry[int]]{{Key: "a", Value: 1}})
+	}
+}
+
+func TestLowerHash(t *testing.T) {
+	lowered := Lower[HashEntry[int]](Hash[int]{"a": 1, "b": 2})
+	got := foldable.Filter(lowered, func(x foldable.T) bool { return x.(foldable.HashEntry).Value.(int) > 1 })
+	expected := foldable.Hash{"b": 2}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	// a List of entries gives untyped entries as well
+	list := Lower[HashEntry[int]](List[HashEntry[int]]{{Key: "a", Value: 1}})
+	if items := foldable.ToList(list); !reflect.DeepEqual(items, []foldable.T{foldable.HashEntry{Key: "a", Value: 1}}) {
+		t.Errorf("result == %v expected %v", items, []foldable.T{foldable.HashEntry{Key: "a", Value: 1}})
+	}
+}
+
+// stream is a third party foldable.Async, which Lift should keep Async
+type stream struct {
+	items chan foldable.T
+}
+
+func streamOf(items ...foldable.T) stream {
+	s := stream{items: make(chan foldable.T)}
+	go func() {
+		for _, item := range items {
+			s.items <- item
+		}
+		close(s.items)
+	}()
+	return s
+}
+
+func (s stream) Foldl(init foldable.T, foldFunc func(result, next foldable.T) foldable.T) foldable.T {
+	return foldable.FoldlAsync(init, func(each func(foldable.T) bool) {
+		for item := range s.items {
+			if !each(item) {
+				return
+			}
+		}
+	}, func(result, next foldable.T) (foldable.T, bool) {
+		return foldFunc(result, next), true
+	})
+}
+
+func (s stream) Init() foldable.Foldable {
+	return stream{items: make(chan foldable.T)}
+}
+
+func (s stream) Append(item foldable.T) foldable.Foldable {
+	s.items <- item
+	return s
+}
+
+func (s stream) Close() {
+	close(s.items)
+}
+
+func TestLiftAsync(t *testing.T) {
+	// the result is still being built while it's read, so Map has to return before it is finished
+	mapped := Map(Lift[int](streamOf(1, 2, 3)), func(x int) int { return x * 2 })
+	lowered := Lower(mapped)
+	if _, ok := lowered.(stream); !ok {
+		t.Fatalf("result == %T expected a stream", lowered)
+	}
+	expected := []foldable.T{2, 4, 6}
+	if got := foldable.ToList(lowered); !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	channel := ToChannel(Lift[int](streamOf(1, 2)))
+	if got := foldable.ToList(channel.Untyped()); !reflect.DeepEqual(got, []foldable.T{1, 2}) {
+		t.Errorf("result == %v expected %v", got, []foldable.T{1, 2})
+	}
+}
+
+func TestLowerAsync(t *testing.T) {
+	// Lower would unwrap the stream, so lower checks that wrapping any typed Async keeps it Async
+	wrapped := Lift[int](streamOf(1, 2, 3))
+	filtered := foldable.Filter(lower[int](Filter(wrapped, func(int) bool { return true })), func(x foldable.T) bool { return x.(int) > 1 })
+	expected := []foldable.T{2, 3}
+	if got := foldable.ToList(filtered); !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
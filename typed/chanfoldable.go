@@ -0,0 +1,57 @@
+package typed
+
+// Channel as lazy list
+// see foldable.Channel, this is the same thing but for a single element type
+type Channel[E any] chan E
+
+// Async is the typed version of foldable.Async, for Foldables which are read while they are still being built
+// a fold into an Async result happens in a go func, which closes the result at the end
+// it has no element type, so a fold can check its result without knowing what the result holds
+type Async interface {
+	// Close is called once nothing more will be appended
+	Close()
+}
+
+func (channel Channel[E]) Close() {
+	close(channel)
+}
+
+func (channel Channel[E]) Foldl(init any, foldFunc func(result any, next E) any) any {
+	return foldlAsync(init, func(each func(E)) {
+		for item := range channel {
+			each(item)
+		}
+	}, foldFunc)
+}
+
+// foldlAsync is the typed version of foldable.FoldlAsync, without the early stop
+// if the result is Async, the fold happens in a go func which closes the result at the end
+// and foldFunc is relying on Append to mutate the result, rather than returning a new one
+func foldlAsync[E any](init any, read func(each func(E)), foldFunc func(result any, next E) any) any {
+	result := init
+	if async, ok := result.(Async); ok {
+		// if the result is a channel we don't want to block on processing,
+		// so we process in a go func and return the result channel
+		go func() {
+			read(func(item E) {
+				foldFunc(result, item)
+			})
+			async.Close()
+		}()
+		return result
+	}
+	read(func(item E) {
+		result = foldFunc(result, item)
+	})
+	return result
+}
+
+func (channel Channel[E]) Init() Foldable[E] {
+	return make(Channel[E])
+}
+
+func (channel Channel[E]) Append(item E) Foldable[E] {
+	// as above, this mutates the passed in channel, which is the only way to use channels in go
+	channel <- item
+	return channel
+}
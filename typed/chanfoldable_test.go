@@ -0,0 +1,31 @@
+package typed
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChannelMap(t *testing.T) {
+	expected := []int{2, 4, 6}
+	got := ToList(Map(ToChannel[int](List[int]{1, 2, 3}), func(x int) int { return x * 2 }))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelMapToType(t *testing.T) {
+	expected := []bool{true, false, true}
+	channel := MapToType(Channel[bool](nil), ToChannel[int](List[int]{1, 2, 3}), func(x int) bool { return x%2 == 1 })
+	got := ToList(channel)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestChannelParMap(t *testing.T) {
+	expected := []int{2, 3, 4}
+	got := ToList(ParMap(ToChannel[int](List[int]{1, 2, 3}), func(x int) int { return x + 1 }))
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
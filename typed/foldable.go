@@ -0,0 +1,209 @@
+package typed
+
+import (
+	"sync"
+)
+
+// the foldable package was written before go had generics, so everything is folded over the abstract type T
+// that means every call site casts to and from T, and the wrong cast is only found at runtime
+// this package is the same idea, but with the element type as a type parameter
+// as far as possible each function here mirrors the one of the same name in the foldable package
+
+// Foldable is the type parameterised version of foldable.Foldable
+// methods in go can't have their own type parameters, so the result of the fold method is still untyped
+// the Foldl function below does that cast in a single place, so callers never need to
+type Foldable[E any] interface {
+	// the main way to process the Items within a Foldable
+	Foldl(init any, f func(result any, next E) any) any
+	// there needs to be a way to create an empty version
+	Init() Foldable[E]
+	// there needs to be a way to combine an Item and a Foldable
+	Append(item E) Foldable[E]
+}
+
+// Foldl is a typed left fold over any Foldable
+func Foldl[E, R any](foldable Foldable[E], init R, foldFunc func(result R, next E) R) R {
+	return as[R](foldable.Foldl(init, func(result any, next E) any {
+		return foldFunc(as[R](result), next)
+	}))
+}
+
+// Map applies a function to each item inside the foldable
+func Map[E any](foldable Foldable[E], mapFunc func(E) E) Foldable[E] {
+	return MapToType(foldable, foldable, mapFunc)
+}
+
+// MapToType is the same as Map, but requires a target type in order to convert to a different type of Foldable result
+// unlike the untyped version, this is also the only way to change the element type
+func MapToType[E, F any](target Foldable[F], foldable Foldable[E], mapFunc func(E) F) Foldable[F] {
+	return Foldl(foldable, target.Init(), func(result Foldable[F], next E) Foldable[F] {
+		return result.Append(mapFunc(next))
+	})
+}
+
+// Filter returns all the items which pass the filter func
+func Filter[E any](foldable Foldable[E], filterFunc func(E) bool) Foldable[E] {
+	return Foldl(foldable, foldable.Init(), func(result Foldable[E], next E) Foldable[E] {
+		if filterFunc(next) {
+			return result.Append(next)
+		}
+		return result
+	})
+}
+
+// Length returns the number of items contained in a foldable
+func Length[E any](foldable Foldable[E]) int {
+	return Foldl(foldable, 0, func(result int, next E) int {
+		return result + 1
+	})
+}
+
+// All returns true if all items pass the filterFunc
+func All[E any](foldable Foldable[E], filterFunc func(E) bool) bool {
+	return Foldl(foldable, true, func(result bool, next E) bool {
+		return result && filterFunc(next)
+	})
+}
+
+// Any returns true if any of the items pass the filterFunc
+func Any[E any](foldable Foldable[E], filterFunc func(E) bool) bool {
+	return Foldl(foldable, false, func(result bool, next E) bool {
+		return result || filterFunc(next)
+	})
+}
+
+// Concat concatenates the parameters
+func Concat[E any](a, b Foldable[E]) Foldable[E] {
+	return Foldl(b, a, func(result Foldable[E], next E) Foldable[E] {
+		return result.Append(next)
+	})
+}
+
+// an internal type to store temporary result values
+// unlike the untyped version, one definition covers every element type
+type intAndFoldable[E any] struct {
+	Int      int
+	Foldable Foldable[E]
+}
+
+// Take will return the first n Items in a Foldable
+func Take[E any](foldable Foldable[E], number int) Foldable[E] {
+	init := intAndFoldable[E]{Int: 0, Foldable: foldable.Init()}
+	return Foldl(foldable, init, func(result intAndFoldable[E], next E) intAndFoldable[E] {
+		if result.Int < number {
+			return intAndFoldable[E]{Int: result.Int + 1, Foldable: result.Foldable.Append(next)}
+		}
+		return result
+	}).Foldable
+}
+
+// Drop will return only the items after the first n Items in a Foldable
+func Drop[E any](foldable Foldable[E], number int) Foldable[E] {
+	init := intAndFoldable[E]{Int: 0, Foldable: foldable.Init()}
+	return Foldl(foldable, init, func(result intAndFoldable[E], next E) intAndFoldable[E] {
+		if result.Int >= number {
+			return intAndFoldable[E]{Int: result.Int + 1, Foldable: result.Foldable.Append(next)}
+		}
+		return intAndFoldable[E]{Int: result.Int + 1, Foldable: result.Foldable}
+	}).Foldable
+}
+
+func async[E any](waitGroup *sync.WaitGroup, mapFunc func() E) *E {
+	var r E
+	waitGroup.Add(1)
+	go func() {
+		r = mapFunc()
+		waitGroup.Done()
+	}()
+	return &r
+}
+
+// ParMap applies a function in parallel to each item inside the foldable
+func ParMap[E any](foldable Foldable[E], mapFunc func(E) E) Foldable[E] {
+	waitGroup := &sync.WaitGroup{}
+	// convert each element to a space for the result, use the list foldable for this
+	// this maintains order while the mapFunc is processed in a go func
+	pendingResults := MapToType(List[*E]{}, foldable, func(next E) *E {
+		return async(waitGroup, func() E { return mapFunc(next) })
+	})
+	// wait for all go funcs to finish
+	waitGroup.Wait()
+	// convert the result pointers back to the original type
+	derefPointer := func(next *E) E { return *next }
+	if _, ok := foldable.(Async); ok {
+		// channels need special handling, the list of results needs to be fed through a channel
+		return MapToType(foldable, Foldable[*E](ToChannel(pendingResults)), derefPointer)
+	}
+	return MapToType(foldable, pendingResults, derefPointer)
+}
+
+// Pair a tuple of two somethings
+type Pair[L, R any] struct {
+	Left  L
+	Right R
+}
+
+// Partition returns a the set of elements which both pass and fail the filter function
+func Partition[E any](foldable Foldable[E], filterFunc func(E) bool) (pass, failed Foldable[E]) {
+	init := Pair[Foldable[E], Foldable[E]]{foldable.Init(), foldable.Init()}
+	result := Foldl(foldable, init, func(result Pair[Foldable[E], Foldable[E]], next E) Pair[Foldable[E], Foldable[E]] {
+		if filterFunc(next) {
+			return Pair[Foldable[E], Foldable[E]]{Left: result.Left.Append(next), Right: result.Right}
+		}
+		return Pair[Foldable[E], Foldable[E]]{Left: result.Left, Right: result.Right.Append(next)}
+	})
+	return result.Left, result.Right
+}
+
+// ToList converts any foldable into a slice
+func ToList[E any](foldable Foldable[E]) []E {
+	return Foldl(foldable, []E{}, func(result []E, next E) []E {
+		return append(result, next)
+	})
+}
+
+// Zip combines corresponding pairs of values, only up to the shortest of a and b
+// a Foldable[A] can't hold pairs, so unlike the untyped version the result is always a List
+func Zip[A, B any](a Foldable[A], b Foldable[B]) List[Pair[A, B]] {
+	result := List[Pair[A, B]]{}
+	bList := ToList(b)
+	for i, x := range ToList(a) {
+		if len(bList) > i {
+			result = append(result, Pair[A, B]{x, bList[i]})
+		}
+	}
+	return result
+}
+
+// Unzip is the reverse process of Zip, with a target type for each side of the pair
+func Unzip[A, B any](leftTarget Foldable[A], rightTarget Foldable[B], zipped Foldable[Pair[A, B]]) (left Foldable[A], right Foldable[B]) {
+	init := Pair[Foldable[A], Foldable[B]]{leftTarget.Init(), rightTarget.Init()}
+	result := Foldl(zipped, init, func(result Pair[Foldable[A], Foldable[B]], next Pair[A, B]) Pair[Foldable[A], Foldable[B]] {
+		return Pair[Foldable[A], Foldable[B]]{Left: result.Left.Append(next.Left), Right: result.Right.Append(next.Right)}
+	})
+	return result.Left, result.Right
+}
+
+// ToChannel alternative to MapToType (but for Channel)
+// see foldable.ToChannel for why this is needed
+func ToChannel[E any](foldable Foldable[E]) Channel[E] {
+	if channel, ok := foldable.(Channel[E]); ok {
+		// already a channel, and folding it into another would close the result twice
+		return channel
+	}
+	result := make(Channel[E])
+	if _, ok := foldable.(Async); ok {
+		// an Async already folds into the channel in a go func, and closes it at the end
+		Foldl(foldable, Foldable[E](result), func(result Foldable[E], next E) Foldable[E] {
+			return result.Append(next)
+		})
+		return result
+	}
+	go func() {
+		Foldl(foldable, Foldable[E](result), func(result Foldable[E], next E) Foldable[E] {
+			return result.Append(next)
+		})
+		close(result)
+	}()
+	return result
+}
@@ -0,0 +1,42 @@
+package typed
+
+import (
+	"sort"
+)
+
+// Hash / Map / Dictionary
+// key is always a string, so we can sort them
+type Hash[V any] map[string]V
+
+// HashEntry is the key value pair
+type HashEntry[V any] struct {
+	Key   string
+	Value V
+}
+
+func (foldable Hash[V]) Foldl(init any, foldFunc func(result any, next HashEntry[V]) any) any {
+	result := init
+	for _, key := range foldable.sortedKeys() {
+		result = foldFunc(result, HashEntry[V]{Key: key, Value: foldable[key]})
+	}
+	return result
+}
+
+func (foldable Hash[V]) Init() Foldable[HashEntry[V]] {
+	return make(Hash[V])
+}
+
+func (foldable Hash[V]) Append(item HashEntry[V]) Foldable[HashEntry[V]] {
+	foldable[item.Key] = item.Value
+	return foldable
+}
+
+func (foldable Hash[V]) sortedKeys() []string {
+	// take and drop depend on order, so we need a guaranteed order
+	keys := make([]string, 0, len(foldable))
+	for k := range foldable {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
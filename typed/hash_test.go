@@ -0,0 +1,34 @@
+package typed
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHashMap(t *testing.T) {
+	expected := Hash[int]{"a": 2, "b": 4, "c": 6}
+	got := Map(Hash[int]{"a": 1, "b": 2, "c": 3}, func(x HashEntry[int]) HashEntry[int] {
+		return HashEntry[int]{Key: x.Key, Value: x.Value * 2}
+	}).(Hash[int])
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashTake(t *testing.T) {
+	expected := Hash[int]{"a": 1, "b": -2, "c": 3}
+	got := Take[HashEntry[int]](Hash[int]{"a": 1, "b": -2, "c": 3, "d": 4, "e": 5, "f": 6}, 3).(Hash[int])
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestHashToListOfValues(t *testing.T) {
+	expected := List[int]{1, 2, 3}
+	got := MapToType(List[int]{}, Hash[int]{"c": 3, "a": 1, "b": 2}, func(x HashEntry[int]) int {
+		return x.Value
+	}).(List[int])
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
@@ -0,0 +1,20 @@
+package typed
+
+// List is a list of anything, but only one kind of anything
+type List[E any] []E
+
+func (list List[E]) Foldl(init any, foldFunc func(result any, next E) any) any {
+	result := init
+	for _, x := range list {
+		result = foldFunc(result, x)
+	}
+	return result
+}
+
+func (list List[E]) Init() Foldable[E] {
+	return List[E]{}
+}
+
+func (list List[E]) Append(item E) Foldable[E] {
+	return append(list, item)
+}
@@ -0,0 +1,116 @@
+package typed
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestListAppend(t *testing.T) {
+	expected := List[int]{1, 2, 3, 4, 5}
+	got := List[int]{1, 2, 3, 4}.Append(5).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListMap(t *testing.T) {
+	expected := List[int]{0, 2, 4}
+	got := Map(List[int]{0, 1, 2}, func(x int) int { return x * 2 }).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListMapToType(t *testing.T) {
+	expected := List[string]{"0", "1", "2"}
+	got := MapToType(List[string]{}, List[int]{0, 1, 2}, strconv.Itoa).(List[string])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	expected := List[int]{-1, -30}
+	got := Filter(List[int]{0, -1, 1, 2, -30}, func(x int) bool { return x < 0 }).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListLength(t *testing.T) {
+	length := Length[int](List[int]{0, -1, 1, 2, -30})
+	if length != 5 {
+		t.Errorf("result == %v expected %v", length, 5)
+	}
+}
+
+func TestListAllAny(t *testing.T) {
+	in := List[int]{0, -1, 1, 2, -30}
+	isNegative := func(x int) bool { return x < 0 }
+	if All[int](in, isNegative) {
+		t.Errorf("result == %v expected %v", true, false)
+	}
+	if !Any[int](in, isNegative) {
+		t.Errorf("result == %v expected %v", false, true)
+	}
+}
+
+func TestListConcat(t *testing.T) {
+	expected := List[int]{1, 2, 3, 4, 5, 6}
+	got := Concat[int](List[int]{1, 2, 3}, List[int]{4, 5, 6}).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListTakeDrop(t *testing.T) {
+	in := List[int]{1, 2, 3, 4, 5, 6}
+	expected := List[int]{1, 2, 3}
+	got := Take[int](in, 3).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	expected = List[int]{4, 5, 6}
+	got = Drop[int](in, 3).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListParMap(t *testing.T) {
+	expected := List[int]{2, 4, 6}
+	got := ParMap(List[int]{1, 2, 3}, func(x int) int { return x * 2 }).(List[int])
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+}
+
+func TestListPartition(t *testing.T) {
+	expectedPass := List[int]{-1, -30}
+	expectedFail := List[int]{0, 1, 2}
+	pass, fail := Partition(List[int]{0, -1, 1, 2, -30}, func(x int) bool { return x < 0 })
+	if !reflect.DeepEqual(expectedPass, pass.(List[int])) {
+		t.Errorf("result == %v expected %v", pass, expectedPass)
+	}
+	if !reflect.DeepEqual(expectedFail, fail.(List[int])) {
+		t.Errorf("result == %v expected %v", fail, expectedFail)
+	}
+}
+
+func TestListZipUnzip(t *testing.T) {
+	a := List[int]{1, 2, 3}
+	b := List[string]{"a", "b", "c", "d"}
+	expected := List[Pair[int, string]]{{1, "a"}, {2, "b"}, {3, "c"}}
+	got := Zip[int, string](a, b)
+	if !reflect.DeepEqual(expected, got) {
+		t.Errorf("result == %v expected %v", got, expected)
+	}
+	gotA, gotB := Unzip[int, string](List[int]{}, List[string]{}, got)
+	if !reflect.DeepEqual(a, gotA) {
+		t.Errorf("result == %v expected %v", gotA, a)
+	}
+	if !reflect.DeepEqual(b[:3], gotB) {
+		t.Errorf("result == %v expected %v", gotB, b[:3])
+	}
+}